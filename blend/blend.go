@@ -0,0 +1,85 @@
+package blend
+
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"github.com/gio-eui/md3-colors/hct"
+	colorUtils "github.com/gio-eui/md3-colors/utils/color"
+	mathUtils "github.com/gio-eui/md3-colors/utils/math"
+	"math"
+)
+
+// Harmonize blends the design color's HCT hue towards the key color's HCT hue, in a way that
+// leaves the original color recognizable and recognizably shifted towards the key color.
+//
+// [designColor] ARGB representation of an arbitrary color.
+// [sourceColor] ARGB representation of the main theme color.
+// Returns the design color with a hue shifted towards the system's color, a slightly
+// warmer/cooler variant of the design color's hue.
+func Harmonize(designColor, sourceColor int) int {
+	fromHct := hct.NewHctFromInt(designColor)
+	toHct := hct.NewHctFromInt(sourceColor)
+	differenceDegrees := mathUtils.DifferenceDegrees(fromHct.GetHue(), toHct.GetHue())
+	rotationDegrees := math.Min(differenceDegrees*0.5, 15.0)
+	outputHue := mathUtils.SanitizeDegreesDouble(
+		fromHct.GetHue() + rotationDegrees*mathUtils.RotationDirection(fromHct.GetHue(), toHct.GetHue()))
+	return hct.NewHct(outputHue, fromHct.GetChroma(), fromHct.GetTone()).ToInt()
+}
+
+// HctHue blends hue from one color into another. The chroma and tone of the original color
+// are maintained.
+//
+// [from] ARGB representation of color
+// [to] ARGB representation of color
+// [amount] how much blending to perform; 0.0 >= and <= 1.0
+// Returns from, with a hue blended towards to. Chroma and tone are constant.
+func HctHue(from, to int, amount float64) int {
+	ucs := Cam16Ucs(from, to, amount)
+	ucsCam := hct.Cam16FromInt(ucs)
+	fromCam := hct.Cam16FromInt(from)
+	blended := hct.NewHct(ucsCam.GetHue(), fromCam.GetChroma(), colorUtils.LstarFromArgb(from))
+	return blended.ToInt()
+}
+
+// Cam16Ucs blends in CAM16-UCS space.
+//
+// [from] ARGB representation of color
+// [to] ARGB representation of color
+// [amount] how much blending to perform; 0.0 >= and <= 1.0
+// Returns from, blended towards to. Hue, chroma, and tone will change.
+func Cam16Ucs(from, to int, amount float64) int {
+	fromCam := hct.Cam16FromInt(from)
+	toCam := hct.Cam16FromInt(to)
+	jstar := fromCam.GetJstar() + (toCam.GetJstar()-fromCam.GetJstar())*amount
+	astar := fromCam.GetAstar() + (toCam.GetAstar()-fromCam.GetAstar())*amount
+	bstar := fromCam.GetBstar() + (toCam.GetBstar()-fromCam.GetBstar())*amount
+	blended := hct.Cam16FromUcs(jstar, astar, bstar)
+	return blended.ToInt()
+}
+
+// Over composites a translucent [foreground] over an opaque [background] in gamma-encoded sRGB,
+// as rendered on screen, and returns the HCT of the result.
+//
+// Contrast and tone checks of translucent overlays must use the composited color, as the
+// foreground alone ignores the background showing through it.
+func Over(foreground, background int) *hct.Hct {
+	return hct.NewHctFromInt(colorUtils.CompositeOver(foreground, colorUtils.ArgbWithAlpha(background, 255)))
+}
+
+// OverLinear composites a translucent [foreground] over an opaque [background] in linear sRGB,
+// and returns the HCT of the result.
+func OverLinear(foreground, background int) *hct.Hct {
+	return hct.NewHctFromInt(colorUtils.CompositeOverLinear(foreground, colorUtils.ArgbWithAlpha(background, 255)))
+}
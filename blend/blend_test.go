@@ -0,0 +1,50 @@
+package blend
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+const (
+	red    = 0xffff0000
+	blue   = 0xff0000ff
+	green  = 0xff00ff00
+	yellow = 0xffffff00
+)
+
+func TestHarmonize(t *testing.T) {
+	assert.Equal(t, 0xfffb0057, Harmonize(red, blue))
+	assert.Equal(t, 0xffd85600, Harmonize(red, green))
+	assert.Equal(t, 0xffd85600, Harmonize(red, yellow))
+	assert.Equal(t, 0xff0047a3, Harmonize(blue, green))
+	assert.Equal(t, 0xff5700dc, Harmonize(blue, red))
+	assert.Equal(t, 0xff0047a3, Harmonize(blue, yellow))
+	assert.Equal(t, 0xff00fc94, Harmonize(green, blue))
+	assert.Equal(t, 0xffb1f000, Harmonize(green, red))
+	assert.Equal(t, 0xffb1f000, Harmonize(green, yellow))
+	assert.Equal(t, 0xffebffba, Harmonize(yellow, blue))
+	assert.Equal(t, 0xffebffba, Harmonize(yellow, green))
+	assert.Equal(t, 0xfffff6e3, Harmonize(yellow, red))
+}
+
+func TestCam16UcsEndpoints(t *testing.T) {
+	assert.Equal(t, red, Cam16Ucs(red, blue, 0.0))
+	assert.InDelta(t, blue&0xff, Cam16Ucs(red, blue, 1.0)&0xff, 1)
+}
+
+func TestHctHueKeepsChromaAndTone(t *testing.T) {
+	assert.Equal(t, red, HctHue(red, blue, 0.0))
+}
+
+func TestOver(t *testing.T) {
+	assert.Equal(t, 0xff808080, Over(0x80ffffff, 0xff000000).ToInt())
+	assert.Equal(t, 0xffbcbcbc, OverLinear(0x80ffffff, 0xff000000).ToInt())
+	assert.Equal(t, 0xff808080, Over(0x80ffffff, 0x00000000).ToInt())
+
+	opaque := Over(0xff6750a4, 0xffffffff)
+	assert.Equal(t, 0xff6750a4, opaque.ToInt())
+	translucent := Over(0x406750a4, 0xffffffff)
+	assert.Greater(t, translucent.GetTone(), opaque.GetTone())
+	assert.Less(t, translucent.GetChroma(), opaque.GetChroma())
+	assert.InDelta(t, opaque.GetHue(), translucent.GetHue(), 10)
+}
@@ -0,0 +1,247 @@
+// Md3gen generates Go source files with precomputed Material Design 3 color tables, so that
+// palettes are available at startup without running the HCT solver.
+//
+// Usage:
+//
+//	md3gen [-pkg name] [-o file] [-variant default|content] [-gio] Name=#rrggbb...
+//
+// For every Name=#rrggbb argument, md3gen declares the constant NameSeed and the variable
+// NameTones, a *palettes.CoreToneTable to pass to palettes.NewCorePaletteFromToneTable. For
+// example, an application can add the following directive to one of its files:
+//
+//	//go:generate go run github.com/gio-eui/md3-colors/cmd/md3gen -o brand.gen.go Brand=#6750a4
+//
+// With -gio, md3gen instead declares the light and dark schemes of every seed as color.NRGBA
+// values for Gio applications, see package gioexport.
+//
+// The -table flag generates the tables of this module instead: "seeds" for the tone tables of
+// well-known seeds in package palettes, and "chroma-grid" for hct.DefaultChromaGrid.
+package main
+
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"github.com/gio-eui/md3-colors/gioexport"
+	"github.com/gio-eui/md3-colors/hct"
+	"github.com/gio-eui/md3-colors/palettes"
+	"github.com/gio-eui/md3-colors/scheme"
+	"github.com/gio-eui/md3-colors/theme"
+	stringsUtils "github.com/gio-eui/md3-colors/utils/strings"
+	"go/format"
+	"go/token"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const header = "// Code generated by md3gen. DO NOT EDIT.\n\n"
+
+// seed is a named seed color given on the command line.
+type seed struct {
+	name string
+	argb int
+}
+
+func main() {
+	pkg := flag.String("pkg", os.Getenv("GOPACKAGE"), "package of the generated file; defaults to $GOPACKAGE")
+	output := flag.String("o", "", "output file; defaults to the standard output")
+	variant := flag.String("variant", scheme.VariantDefault.String(), `scheme variant of the seeds: "default" or "content"`)
+	gio := flag.Bool("gio", false, "generate the schemes of the seeds as Gio colors")
+	table := flag.String("table", "", `generate a table of this module: "seeds" or "chroma-grid"`)
+	flag.Parse()
+
+	if err := run(*pkg, *output, *variant, *gio, *table, flag.Args()); err != nil {
+		fmt.Fprintln(os.Stderr, "md3gen:", err)
+		os.Exit(1)
+	}
+}
+
+// run generates the requested file and writes it to [output], or to the standard output.
+func run(pkg, output, variantName string, gio bool, table string, args []string) error {
+	if !token.IsIdentifier(pkg) {
+		return fmt.Errorf("invalid package name %q; set -pkg", pkg)
+	}
+	variant, err := scheme.ParseVariant(variantName)
+	if err != nil {
+		return err
+	}
+	seeds, err := parseSeeds(args)
+	if err != nil {
+		return err
+	}
+
+	var src []byte
+	switch table {
+	case "":
+		if len(seeds) == 0 {
+			return fmt.Errorf("no seed colors given")
+		}
+		if gio {
+			src, err = generateGio(pkg, variant, seeds)
+		} else {
+			src, err = generateSeeds(pkg, variant == scheme.VariantContent, seeds)
+		}
+	case "seeds":
+		src, err = generateSeedTables(pkg, seeds)
+	case "chroma-grid":
+		src, err = generateChromaGrid(pkg)
+	default:
+		return fmt.Errorf("unknown table %q", table)
+	}
+	if err != nil {
+		return err
+	}
+
+	if output == "" {
+		_, err = os.Stdout.Write(src)
+		return err
+	}
+	return os.WriteFile(output, src, 0o644)
+}
+
+// parseSeeds parses Name=#rrggbb arguments.
+func parseSeeds(args []string) ([]seed, error) {
+	seeds := make([]seed, 0, len(args))
+	for _, arg := range args {
+		name, hex, ok := strings.Cut(arg, "=")
+		if !ok || !token.IsIdentifier(name) {
+			return nil, fmt.Errorf("invalid seed %q, want Name=#rrggbb", arg)
+		}
+		argb, err := stringsUtils.ArgbFromHex(hex)
+		if err != nil {
+			return nil, err
+		}
+		seeds = append(seeds, seed{name: name, argb: argb})
+	}
+	return seeds, nil
+}
+
+// generateSeeds generates the seed constants and core tone tables of an application.
+func generateSeeds(pkg string, content bool, seeds []seed) ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteString(header)
+	fmt.Fprintf(&b, "package %s\n\n", pkg)
+	fmt.Fprintf(&b, "import \"github.com/gio-eui/md3-colors/palettes\"\n")
+	for _, s := range seeds {
+		fmt.Fprintf(&b, "\n// %sSeed is the seed color of %sTones.\n", s.name, s.name)
+		fmt.Fprintf(&b, "const %sSeed = 0x%08x\n", s.name, s.argb)
+		fmt.Fprintf(&b, "\n// %sTones holds the precomputed core palette of %sSeed, see\n", s.name, s.name)
+		fmt.Fprintf(&b, "// palettes.NewCorePaletteFromToneTable.\n")
+		fmt.Fprintf(&b, "var %sTones = &palettes.CoreToneTable", s.name)
+		writeCoreToneTable(&b, "palettes.", palettes.NewCoreToneTable(s.argb, content))
+		b.WriteString("\n")
+	}
+	return format.Source(b.Bytes())
+}
+
+// generateGio generates the Gio schemes of an application, see package gioexport.
+func generateGio(pkg string, variant scheme.Variant, seeds []seed) ([]byte, error) {
+	themes := make([]gioexport.Theme, len(seeds))
+	for i, s := range seeds {
+		t, err := theme.NewTheme(s.argb, variant)
+		if err != nil {
+			return nil, err
+		}
+		themes[i] = gioexport.Theme{Name: s.name, Theme: t}
+	}
+	return gioexport.Generate(pkg, themes...)
+}
+
+// generateSeedTables generates the core and content tone tables of well-known seeds, for
+// package palettes. The tables are assigned from init, so palettes compiles without them.
+func generateSeedTables(pkg string, seeds []seed) ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteString(header)
+	fmt.Fprintf(&b, "package %s\n\nfunc init() {\n", pkg)
+	for _, content := range []bool{false, true} {
+		name := "seedToneTables"
+		if content {
+			name = "contentSeedToneTables"
+		}
+		fmt.Fprintf(&b, "%s = map[int]*CoreToneTable{\n", name)
+		for _, s := range seeds {
+			fmt.Fprintf(&b, "// %s\n0x%08x: ", s.name, s.argb)
+			writeCoreToneTable(&b, "", palettes.NewCoreToneTable(s.argb, content))
+			b.WriteString(",\n")
+		}
+		b.WriteString("}\n")
+	}
+	b.WriteString("}\n")
+	return format.Source(b.Bytes())
+}
+
+// generateChromaGrid generates the samples of hct.DefaultChromaGrid, for package hct. The
+// samples are assigned from init, so hct compiles without them.
+func generateChromaGrid(pkg string) ([]byte, error) {
+	grid, err := hct.NewChromaGrid(hct.DefaultChromaGridHueStep, hct.DefaultChromaGridToneStep)
+	if err != nil {
+		return nil, err
+	}
+	samples := grid.Samples()
+
+	var b bytes.Buffer
+	b.WriteString(header)
+	fmt.Fprintf(&b, "package %s\n\n", pkg)
+	fmt.Fprintf(&b, "func init() {\ndefaultChromaGridSamples = generatedChromaGridSamples[:]\n}\n\n")
+	fmt.Fprintf(&b, "var generatedChromaGridSamples = [%d]float64{", len(samples))
+	for i, sample := range samples {
+		if i%8 == 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "%s, ", formatFloat(sample))
+	}
+	b.WriteString("\n}\n")
+	return format.Source(b.Bytes())
+}
+
+// writeCoreToneTable writes [table] as a composite literal, qualifying types with [qualifier].
+func writeCoreToneTable(w io.Writer, qualifier string, table palettes.CoreToneTable) {
+	fmt.Fprintf(w, "{\n")
+	for _, palette := range []struct {
+		name  string
+		table palettes.ToneTable
+	}{
+		{"A1", table.A1},
+		{"A2", table.A2},
+		{"A3", table.A3},
+		{"N1", table.N1},
+		{"N2", table.N2},
+		{"Error", table.Error},
+	} {
+		fmt.Fprintf(w, "%s: %sToneTable{\nHue: %s,\nChroma: %s,\nTones: [101]int{", palette.name, qualifier,
+			formatFloat(palette.table.Hue), formatFloat(palette.table.Chroma))
+		for tone, argb := range palette.table.Tones {
+			if tone%8 == 0 {
+				fmt.Fprintf(w, "\n")
+			}
+			fmt.Fprintf(w, "0x%08x, ", argb)
+		}
+		fmt.Fprintf(w, "\n},\n},\n")
+	}
+	fmt.Fprintf(w, "}")
+}
+
+// formatFloat formats [value] as a Go literal that parses back to exactly the same value.
+func formatFloat(value float64) string {
+	literal := strconv.FormatFloat(value, 'g', -1, 64)
+	if !strings.ContainsAny(literal, ".e") {
+		literal += ".0"
+	}
+	return literal
+}
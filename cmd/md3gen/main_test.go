@@ -0,0 +1,58 @@
+package main
+
+import (
+	"github.com/gio-eui/md3-colors/scheme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go/parser"
+	"go/token"
+	"testing"
+)
+
+func TestGenerateSeeds(t *testing.T) {
+	seeds, err := parseSeeds([]string{"Brand=#6750a4", "Accent=0xff00796b"})
+	require.NoError(t, err)
+	assert.Equal(t, []seed{{"Brand", 0xff6750a4}, {"Accent", 0xff00796b}}, seeds)
+
+	src, err := generateSeeds("theme", false, seeds)
+	require.NoError(t, err)
+	file, err := parser.ParseFile(token.NewFileSet(), "brand.gen.go", src, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "theme", file.Name.Name)
+	for _, name := range []string{"BrandSeed", "BrandTones", "AccentSeed", "AccentTones"} {
+		assert.NotNil(t, file.Scope.Lookup(name), name)
+	}
+	assert.Contains(t, string(src), "const BrandSeed = 0xff6750a4\n")
+	assert.Contains(t, string(src), "0xff6750a4, 0xff6952a7")
+}
+
+func TestParseSeedsRejectsInvalidArguments(t *testing.T) {
+	for _, arg := range []string{"#6750a4", "1st=#6750a4", "Brand=#6750a"} {
+		_, err := parseSeeds([]string{arg})
+		assert.Error(t, err, arg)
+	}
+}
+
+func TestFormatFloat(t *testing.T) {
+	assert.Equal(t, "48.0", formatFloat(48))
+	assert.Equal(t, "298.98099721070395", formatFloat(298.98099721070395))
+	assert.Equal(t, "1e-05", formatFloat(0.00001))
+}
+
+func TestGenerateGio(t *testing.T) {
+	src, err := generateGio("theme", scheme.VariantContent, []seed{{"Brand", 0xff6750a4}})
+	require.NoError(t, err)
+	assert.Contains(t, string(src), "with the content variant")
+	assert.Contains(t, string(src), "var BrandDark = BrandScheme{")
+}
+
+func TestGenerateSeedTablesAssignsFromInit(t *testing.T) {
+	src, err := generateSeedTables("palettes", []seed{{"Baseline", 0xff6750a4}})
+	require.NoError(t, err)
+	file, err := parser.ParseFile(token.NewFileSet(), "seeds.gen.go", src, 0)
+	require.NoError(t, err)
+	// The tables are declared by package palettes, so that it compiles without this file.
+	assert.Nil(t, file.Scope.Lookup("seedToneTables"))
+	assert.Contains(t, string(src), "func init() {\n\tseedToneTables = map[int]*CoreToneTable{")
+	assert.Contains(t, string(src), "\tcontentSeedToneTables = map[int]*CoreToneTable{")
+}
@@ -0,0 +1,172 @@
+package colordiff
+
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"github.com/gio-eui/md3-colors/hct"
+	colorUtils "github.com/gio-eui/md3-colors/utils/color"
+	mathUtils "github.com/gio-eui/md3-colors/utils/math"
+	"math"
+)
+
+// Metric measures the perceptual difference, ΔE, between two colors in ARGB format.
+//
+// A ΔE of 0 means the colors are identical; a ΔE around 1 is the smallest difference most
+// observers notice side by side.
+type Metric func(a, b int) float64
+
+// Cam16Ucs returns the color difference between [a] and [b] in CAM16-UCS, the metric HCT is
+// built on.
+func Cam16Ucs(a, b int) float64 {
+	camA := hct.Cam16FromInt(a)
+	camB := hct.Cam16FromInt(b)
+	return camA.Distance(&camB)
+}
+
+// Cie76 returns the CIE 1976 color difference between [a] and [b], the Euclidean distance
+// in L*a*b*.
+func Cie76(a, b int) float64 {
+	return Cie76Lab(colorUtils.LabFromArgb(a), colorUtils.LabFromArgb(b))
+}
+
+// Cie76Lab returns the CIE 1976 color difference between two L*a*b* colors.
+func Cie76Lab(lab1, lab2 []float64) float64 {
+	dL := lab1[0] - lab2[0]
+	dA := lab1[1] - lab2[1]
+	dB := lab1[2] - lab2[2]
+	return math.Sqrt(dL*dL + dA*dA + dB*dB)
+}
+
+// Cie94 returns the CIE 1994 color difference between [a] and [b], using the graphic arts
+// weights. CIE94 is not symmetric; [a] is the reference color.
+func Cie94(a, b int) float64 {
+	return Cie94Lab(colorUtils.LabFromArgb(a), colorUtils.LabFromArgb(b))
+}
+
+// Cie94Lab returns the CIE 1994 color difference between a reference L*a*b* color [lab1]
+// and a sample L*a*b* color [lab2], using the graphic arts weights.
+func Cie94Lab(lab1, lab2 []float64) float64 {
+	const kL = 1.0
+	const k1 = 0.045
+	const k2 = 0.015
+	c1 := math.Hypot(lab1[1], lab1[2])
+	c2 := math.Hypot(lab2[1], lab2[2])
+	dL := lab1[0] - lab2[0]
+	dC := c1 - c2
+	dA := lab1[1] - lab2[1]
+	dB := lab1[2] - lab2[2]
+	dH2 := math.Max(0.0, dA*dA+dB*dB-dC*dC)
+	sC := 1.0 + k1*c1
+	sH := 1.0 + k2*c1
+	termL := dL / kL
+	termC := dC / sC
+	return math.Sqrt(termL*termL + termC*termC + dH2/(sH*sH))
+}
+
+// Ciede2000 returns the CIEDE2000 color difference between [a] and [b].
+func Ciede2000(a, b int) float64 {
+	return Ciede2000Lab(colorUtils.LabFromArgb(a), colorUtils.LabFromArgb(b))
+}
+
+// Ciede2000Lab returns the CIEDE2000 color difference between two L*a*b* colors.
+//
+// The implementation follows Sharma, Wu, and Dalal, "The CIEDE2000 Color-Difference
+// Formula: Implementation Notes, Supplementary Test Data, and Mathematical Observations".
+func Ciede2000Lab(lab1, lab2 []float64) float64 {
+	l1, a1, b1 := lab1[0], lab1[1], lab1[2]
+	l2, a2, b2 := lab2[0], lab2[1], lab2[2]
+
+	cBar := (math.Hypot(a1, b1) + math.Hypot(a2, b2)) / 2.0
+	cBar7 := math.Pow(cBar, 7.0)
+	g := 0.5 * (1.0 - math.Sqrt(cBar7/(cBar7+math.Pow(25.0, 7.0))))
+	a1Prime := (1.0 + g) * a1
+	a2Prime := (1.0 + g) * a2
+	c1Prime := math.Hypot(a1Prime, b1)
+	c2Prime := math.Hypot(a2Prime, b2)
+	h1Prime := hueAngle(b1, a1Prime)
+	h2Prime := hueAngle(b2, a2Prime)
+
+	dLPrime := l2 - l1
+	dCPrime := c2Prime - c1Prime
+	dhPrime := 0.0
+	if c1Prime*c2Prime != 0 {
+		dhPrime = h2Prime - h1Prime
+		if dhPrime > 180.0 {
+			dhPrime -= 360.0
+		} else if dhPrime < -180.0 {
+			dhPrime += 360.0
+		}
+	}
+	dHPrime := 2.0 * math.Sqrt(c1Prime*c2Prime) * math.Sin(mathUtils.ToRadians(dhPrime/2.0))
+
+	lBarPrime := (l1 + l2) / 2.0
+	cBarPrime := (c1Prime + c2Prime) / 2.0
+	hBarPrime := h1Prime + h2Prime
+	if c1Prime*c2Prime != 0 {
+		if math.Abs(h1Prime-h2Prime) <= 180.0 {
+			hBarPrime /= 2.0
+		} else if hBarPrime < 360.0 {
+			hBarPrime = (hBarPrime + 360.0) / 2.0
+		} else {
+			hBarPrime = (hBarPrime - 360.0) / 2.0
+		}
+	}
+
+	t := 1.0 -
+		0.17*math.Cos(mathUtils.ToRadians(hBarPrime-30.0)) +
+		0.24*math.Cos(mathUtils.ToRadians(2.0*hBarPrime)) +
+		0.32*math.Cos(mathUtils.ToRadians(3.0*hBarPrime+6.0)) -
+		0.20*math.Cos(mathUtils.ToRadians(4.0*hBarPrime-63.0))
+	dTheta := 30.0 * math.Exp(-math.Pow((hBarPrime-275.0)/25.0, 2.0))
+	cBarPrime7 := math.Pow(cBarPrime, 7.0)
+	rC := 2.0 * math.Sqrt(cBarPrime7/(cBarPrime7+math.Pow(25.0, 7.0)))
+	lBarPrimeMinus50Squared := (lBarPrime - 50.0) * (lBarPrime - 50.0)
+	sL := 1.0 + 0.015*lBarPrimeMinus50Squared/math.Sqrt(20.0+lBarPrimeMinus50Squared)
+	sC := 1.0 + 0.045*cBarPrime
+	sH := 1.0 + 0.015*cBarPrime*t
+	rT := -math.Sin(mathUtils.ToRadians(2.0*dTheta)) * rC
+
+	termL := dLPrime / sL
+	termC := dCPrime / sC
+	termH := dHPrime / sH
+	return math.Sqrt(termL*termL + termC*termC + termH*termH + rT*termC*termH)
+}
+
+// Deduplicate returns [argbs] without colors whose difference to an earlier color, measured
+// by [metric], is below [threshold]. The order of the remaining colors is preserved.
+func Deduplicate(argbs []int, threshold float64, metric Metric) []int {
+	var unique []int
+	for _, argb := range argbs {
+		duplicate := false
+		for _, kept := range unique {
+			if metric(kept, argb) < threshold {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			unique = append(unique, argb)
+		}
+	}
+	return unique
+}
+
+// hueAngle returns the hue angle of a color in degrees, between 0 and 360.
+func hueAngle(b, aPrime float64) float64 {
+	if b == 0 && aPrime == 0 {
+		return 0.0
+	}
+	return mathUtils.SanitizeDegreesDouble(mathUtils.ToDegrees(math.Atan2(b, aPrime)))
+}
@@ -0,0 +1,63 @@
+package colordiff
+
+import (
+	"bufio"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestCiede2000SharmaDataset(t *testing.T) {
+	file, err := os.Open("testdata/sharma_ciede2000.csv")
+	require.NoError(t, err)
+	defer file.Close()
+
+	pairs := 0
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, ",")
+		require.Len(t, fields, 7)
+		values := make([]float64, len(fields))
+		for i, field := range fields {
+			values[i], err = strconv.ParseFloat(field, 64)
+			require.NoError(t, err)
+		}
+		lab1 := values[0:3]
+		lab2 := values[3:6]
+		assert.InDelta(t, values[6], Ciede2000Lab(lab1, lab2), 0.0001, "pair %d", pairs+1)
+		assert.InDelta(t, values[6], Ciede2000Lab(lab2, lab1), 0.0001, "pair %d reversed", pairs+1)
+		pairs++
+	}
+	require.NoError(t, scanner.Err())
+	assert.Equal(t, 34, pairs)
+}
+
+func TestCie76AndCie94(t *testing.T) {
+	lab1 := []float64{50, 2.5, 0}
+	lab2 := []float64{73, 25, -18}
+	assert.InDelta(t, 36.8681, Cie76Lab(lab1, lab2), 0.0001)
+	assert.InDelta(t, 0.0, Cie94Lab(lab1, lab1), 1e-12)
+	assert.Less(t, Cie94Lab(lab1, lab2), Cie76Lab(lab1, lab2))
+}
+
+func TestMetricsOnArgb(t *testing.T) {
+	for _, metric := range []Metric{Cam16Ucs, Cie76, Cie94, Ciede2000} {
+		assert.InDelta(t, 0.0, metric(0xff6750a4, 0xff6750a4), 1e-9)
+		assert.Greater(t, metric(0xff000000, 0xffffffff), metric(0xff6750a4, 0xff6751a4))
+	}
+	assert.InDelta(t, 100.0, Cie76(0xff000000, 0xffffffff), 0.01)
+}
+
+func TestDeduplicate(t *testing.T) {
+	colors := []int{0xff6750a4, 0xff6751a4, 0xffb3261e, 0xff6750a5, 0xffb3271e, 0xff00ff00}
+	assert.Equal(t, []int{0xff6750a4, 0xffb3261e, 0xff00ff00}, Deduplicate(colors, 1.0, Ciede2000))
+	assert.Equal(t, colors, Deduplicate(colors, 0.0, Ciede2000))
+	assert.Nil(t, Deduplicate(nil, 1.0, Cam16Ucs))
+}
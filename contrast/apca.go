@@ -0,0 +1,168 @@
+package contrast
+
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	colorUtils "github.com/gio-eui/md3-colors/utils/color"
+	"math"
+)
+
+// The functions below implement the lightness contrast of APCA 0.0.98G-4g, the Accessible
+// Perceptual Contrast Algorithm proposed for WCAG 3.
+//
+// Unlike the WCAG 2 ratio, APCA depends on which color is the text: its lightness contrast Lc
+// is positive for dark text on a light background, and negative for light text on a dark
+// background. Lc ranges from about 106 to about -108; guidelines compare its absolute value
+// with thresholds such as 75 for body text, 60 for other text, and 45 for large text.
+
+const (
+	apcaNormBg         = 0.56
+	apcaNormText       = 0.57
+	apcaRevText        = 0.62
+	apcaRevBg          = 0.65
+	apcaBlackThreshold = 0.022
+	apcaBlackClamp     = 1.414
+	apcaScale          = 1.14
+	apcaLoOffset       = 0.027
+	apcaLoClip         = 0.1
+	apcaDeltaYMin      = 0.0005
+)
+
+// Polarity tells whether text is darker or lighter than its background.
+type Polarity int
+
+const (
+	// PolarityDarkOnLight is the polarity of dark text on a light background, with positive Lc.
+	PolarityDarkOnLight Polarity = iota
+	// PolarityLightOnDark is the polarity of light text on a dark background, with negative Lc.
+	PolarityLightOnDark
+)
+
+// String returns the name of the polarity.
+func (p Polarity) String() string {
+	if p == PolarityLightOnDark {
+		return "light on dark"
+	}
+	return "dark on light"
+}
+
+// PolarityOf returns the polarity of a lightness contrast [lc].
+func PolarityOf(lc float64) Polarity {
+	if lc < 0 {
+		return PolarityLightOnDark
+	}
+	return PolarityDarkOnLight
+}
+
+// LcOfArgbs returns the APCA lightness contrast of [text] drawn on [background], both in ARGB
+// format. Alpha is ignored; use colorUtils.CompositeOver to measure translucent text.
+func LcOfArgbs(text, background int) float64 {
+	return lcOfYs(apcaYFromArgb(text), apcaYFromArgb(background))
+}
+
+// LcOfTones returns the APCA lightness contrast of text of tone [textTone] drawn on a
+// background of tone [backgroundTone], tones being between 0.0 and 100.0.
+//
+// APCA measures luminance slightly differently from L*, so colors of a tone are represented by
+// the gray of that tone; the result is within about 1 Lc of LcOfArgbs for chromatic colors.
+func LcOfTones(textTone, backgroundTone float64) float64 {
+	return lcOfYs(apcaYFromTone(textTone), apcaYFromTone(backgroundTone))
+}
+
+// ToneForLc returns the tone of text that has a lightness contrast of [lc] against a
+// background of tone [backgroundTone], and true, if such a tone exists; and returns false
+// otherwise.
+//
+// The sign of [lc] selects the polarity: positive values search for darker text, negative
+// values for lighter text. The returned tone is the one closest to [backgroundTone] whose
+// contrast is at least as strong as [lc].
+func ToneForLc(backgroundTone, lc float64) (float64, bool) {
+	backgroundTone = math.Max(0.0, math.Min(100.0, backgroundTone))
+	// meets tells whether text of [tone] contrasts at least as strongly as lc.
+	meets := func(tone float64) bool {
+		return math.Abs(LcOfTones(tone, backgroundTone)) >= math.Abs(lc)
+	}
+	low, high := backgroundTone, 0.0
+	if PolarityOf(lc) == PolarityLightOnDark {
+		high = 100.0
+	}
+	if !meets(high) {
+		return 0.0, false
+	}
+	// Bisect between the background tone, which has no contrast, and the extreme tone, which
+	// always meets lc, keeping high on the side that meets it.
+	for i := 0; i < 32; i++ {
+		mid := (low + high) / 2.0
+		if meets(mid) {
+			high = mid
+		} else {
+			low = mid
+		}
+	}
+	return high, true
+}
+
+// lcOfYs returns the lightness contrast of two APCA luminances.
+func lcOfYs(textY, backgroundY float64) float64 {
+	textY = apcaSoftClamp(textY)
+	backgroundY = apcaSoftClamp(backgroundY)
+	if math.Abs(backgroundY-textY) < apcaDeltaYMin {
+		return 0.0
+	}
+	var output float64
+	if backgroundY > textY {
+		sapc := (math.Pow(backgroundY, apcaNormBg) - math.Pow(textY, apcaNormText)) * apcaScale
+		if sapc >= apcaLoClip {
+			output = sapc - apcaLoOffset
+		}
+	} else {
+		sapc := (math.Pow(backgroundY, apcaRevBg) - math.Pow(textY, apcaRevText)) * apcaScale
+		if sapc <= -apcaLoClip {
+			output = sapc + apcaLoOffset
+		}
+	}
+	return output * 100.0
+}
+
+// apcaSoftClamp raises luminances near black, which APCA treats as flare.
+func apcaSoftClamp(y float64) float64 {
+	if y < apcaBlackThreshold {
+		return y + math.Pow(apcaBlackThreshold-y, apcaBlackClamp)
+	}
+	return y
+}
+
+// apcaYFromArgb returns the APCA luminance of a color, which uses a plain 2.4 exponent rather
+// than the piecewise sRGB transfer function.
+func apcaYFromArgb(argb int) float64 {
+	channel := func(component int) float64 {
+		return math.Pow(float64(component)/255.0, 2.4)
+	}
+	return 0.2126729*channel(colorUtils.RedFromArgb(argb)) +
+		0.7151522*channel(colorUtils.GreenFromArgb(argb)) +
+		0.0721750*channel(colorUtils.BlueFromArgb(argb))
+}
+
+// apcaYFromTone returns the APCA luminance of the gray of [tone], without rounding it to 8-bit
+// components.
+func apcaYFromTone(tone float64) float64 {
+	linear := colorUtils.YFromLstar(math.Max(0.0, math.Min(100.0, tone))) / 100.0
+	encoded := linear * 12.92
+	if linear > 0.0031308 {
+		encoded = 1.055*math.Pow(linear, 1.0/2.4) - 0.055
+	}
+	// The coefficients of apcaYFromArgb sum to 1.0000001, which grays carry along.
+	return 1.0000001 * math.Pow(encoded, 2.4)
+}
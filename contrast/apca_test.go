@@ -0,0 +1,63 @@
+package contrast
+
+import (
+	"github.com/gio-eui/md3-colors/hct"
+	colorUtils "github.com/gio-eui/md3-colors/utils/color"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestLcOfArgbs(t *testing.T) {
+	// Reference values of the APCA 0.0.98G-4g implementation.
+	for _, test := range []struct {
+		text, background int
+		lc               float64
+	}{
+		{0xff888888, 0xffffffff, 63.056469930209424},
+		{0xffffffff, 0xff888888, -68.54146436644962},
+		{0xff000000, 0xffaaaaaa, 58.146262578561334},
+		{0xffaaaaaa, 0xff000000, -56.24113336839742},
+		{0xff112233, 0xffddeeff, 91.66830811481631},
+		{0xffddeeff, 0xff112233, -93.06770049484275},
+		{0xff000000, 0xffffffff, 106.04067321268862},
+		{0xffffffff, 0xff000000, -107.88473318309848},
+	} {
+		assert.InDelta(t, test.lc, LcOfArgbs(test.text, test.background), 1e-9,
+			"%08x on %08x", test.text, test.background)
+	}
+	assert.Equal(t, 0.0, LcOfArgbs(0xff6750a4, 0xff6750a4))
+	assert.Equal(t, 0.0, LcOfArgbs(0xff767676, 0xff777777))
+}
+
+func TestPolarity(t *testing.T) {
+	assert.Equal(t, PolarityDarkOnLight, PolarityOf(LcOfArgbs(0xff000000, 0xffffffff)))
+	assert.Equal(t, PolarityLightOnDark, PolarityOf(LcOfArgbs(0xffffffff, 0xff000000)))
+	assert.Equal(t, "light on dark", PolarityLightOnDark.String())
+}
+
+func TestLcOfTones(t *testing.T) {
+	for tone := 0; tone <= 100; tone += 5 {
+		gray := colorUtils.ArgbFromLstar(float64(tone))
+		assert.InDelta(t, LcOfArgbs(gray, 0xffffffff), LcOfTones(float64(tone), 100), 1.0)
+		assert.InDelta(t, LcOfArgbs(gray, 0xff000000), LcOfTones(float64(tone), 0), 1.0)
+	}
+	purple := hct.NewHctFromInt(0xff6750a4)
+	assert.InDelta(t, LcOfArgbs(0xff6750a4, 0xffffffff), LcOfTones(purple.GetTone(), 100), 1.0)
+}
+
+func TestToneForLc(t *testing.T) {
+	tone, ok := ToneForLc(100, 75)
+	assert.True(t, ok)
+	assert.Less(t, tone, 100.0)
+	assert.InDelta(t, 75, LcOfTones(tone, 100), 0.01)
+
+	tone, ok = ToneForLc(10, -60)
+	assert.True(t, ok)
+	assert.Greater(t, tone, 10.0)
+	assert.InDelta(t, -60, LcOfTones(tone, 10), 0.01)
+
+	_, ok = ToneForLc(50, 90)
+	assert.False(t, ok)
+	_, ok = ToneForLc(0, 30)
+	assert.False(t, ok)
+}
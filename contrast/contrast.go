@@ -0,0 +1,120 @@
+// Package contrast measures and enforces the contrast between colors, with the contrast ratio
+// of WCAG 2 or the lightness contrast of APCA.
+//
+// Ratios range from 1.0, for identical luminances, to 21.0, for black and white. WCAG 2
+// requires 4.5 for body text and 3.0 for large text, or 7.0 and 4.5 at the enhanced level.
+package contrast
+
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	colorUtils "github.com/gio-eui/md3-colors/utils/color"
+	mathUtils "github.com/gio-eui/md3-colors/utils/math"
+	"math"
+)
+
+// RatioOfYs returns the contrast ratio of two relative luminances, Y in XYZ between 0.0 and
+// 100.0.
+func RatioOfYs(y1, y2 float64) float64 {
+	lighter := math.Max(y1, y2)
+	darker := math.Min(y1, y2)
+	return (lighter + 5.0) / (darker + 5.0)
+}
+
+// RatioOfTones returns the contrast ratio of two tones, T in HCT or L* in L*a*b*, between 0.0
+// and 100.0; out of range tones are clamped.
+func RatioOfTones(t1, t2 float64) float64 {
+	t1 = mathUtils.ClampDouble(0.0, 100.0, t1)
+	t2 = mathUtils.ClampDouble(0.0, 100.0, t2)
+	return RatioOfYs(colorUtils.YFromLstar(t1), colorUtils.YFromLstar(t2))
+}
+
+// RatioOfArgbs returns the contrast ratio of two colors in ARGB format. Alpha is ignored; use
+// colorUtils.CompositeOver to measure translucent colors over their background.
+func RatioOfArgbs(argb1, argb2 int) float64 {
+	return RatioOfYs(colorUtils.XyzFromArgb(argb1)[1], colorUtils.XyzFromArgb(argb2)[1])
+}
+
+// Lighter returns a tone greater than or equal to [tone] that ensures [ratio] against it, or
+// -1.0 if no such tone exists.
+//
+// The returned tone is 0.4 above the exact solution, so colors rounded to 8-bit components
+// still meet [ratio].
+func Lighter(tone, ratio float64) float64 {
+	if tone < 0.0 || tone > 100.0 {
+		return -1.0
+	}
+	darkY := colorUtils.YFromLstar(tone)
+	lightY := ratio*(darkY+5.0) - 5.0
+	if lightY < 0.0 || lightY > 100.0 {
+		return -1.0
+	}
+	realContrast := RatioOfYs(lightY, darkY)
+	delta := math.Abs(realContrast - ratio)
+	if realContrast < ratio && delta > 0.04 {
+		return -1.0
+	}
+	returnValue := colorUtils.LstarFromY(lightY) + 0.4
+	if returnValue < 0.0 || returnValue > 100.0 {
+		return -1.0
+	}
+	return returnValue
+}
+
+// Darker returns a tone less than or equal to [tone] that ensures [ratio] against it, or -1.0
+// if no such tone exists.
+//
+// The returned tone is 0.4 below the exact solution, so colors rounded to 8-bit components
+// still meet [ratio].
+func Darker(tone, ratio float64) float64 {
+	if tone < 0.0 || tone > 100.0 {
+		return -1.0
+	}
+	lightY := colorUtils.YFromLstar(tone)
+	darkY := (lightY+5.0)/ratio - 5.0
+	if darkY < 0.0 || darkY > 100.0 {
+		return -1.0
+	}
+	realContrast := RatioOfYs(lightY, darkY)
+	delta := math.Abs(realContrast - ratio)
+	if realContrast < ratio && delta > 0.04 {
+		return -1.0
+	}
+	returnValue := colorUtils.LstarFromY(darkY) - 0.4
+	if returnValue < 0.0 || returnValue > 100.0 {
+		return -1.0
+	}
+	return returnValue
+}
+
+// LighterUnsafe returns Lighter, or 100.0 if no tone ensures [ratio]. 100.0 has the highest
+// contrast of all tones greater than [tone], but may not reach [ratio].
+func LighterUnsafe(tone, ratio float64) float64 {
+	lighterSafe := Lighter(tone, ratio)
+	if lighterSafe < 0.0 {
+		return 100.0
+	}
+	return lighterSafe
+}
+
+// DarkerUnsafe returns Darker, or 0.0 if no tone ensures [ratio]. 0.0 has the highest
+// contrast of all tones less than [tone], but may not reach [ratio].
+func DarkerUnsafe(tone, ratio float64) float64 {
+	darkerSafe := Darker(tone, ratio)
+	if darkerSafe < 0.0 {
+		return 0.0
+	}
+	return darkerSafe
+}
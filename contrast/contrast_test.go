@@ -0,0 +1,35 @@
+package contrast
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRatioOfTones(t *testing.T) {
+	assert.InDelta(t, 21.0, RatioOfTones(0, 100), 0.001)
+	assert.InDelta(t, 21.0, RatioOfTones(-10, 110), 0.001)
+	assert.InDelta(t, 1.0, RatioOfTones(50, 50), 0.001)
+	assert.InDelta(t, 21.0, RatioOfArgbs(0xff000000, 0xffffffff), 0.001)
+	assert.InDelta(t, 4.5, RatioOfArgbs(0xff767676, 0xffffffff), 0.05)
+	assert.Equal(t, RatioOfArgbs(0xff6750a4, 0xffffffff), RatioOfArgbs(0xffffffff, 0xff6750a4))
+}
+
+func TestLighter(t *testing.T) {
+	assert.Equal(t, -1.0, Lighter(90, 10))
+	assert.Equal(t, -1.0, Lighter(110, 2))
+	assert.Equal(t, -1.0, Lighter(-10, 2))
+	assert.Equal(t, 100.0, LighterUnsafe(100, 2))
+	tone := Lighter(20, 4.5)
+	assert.Greater(t, tone, 20.0)
+	assert.GreaterOrEqual(t, RatioOfTones(20, tone), 4.5)
+}
+
+func TestDarker(t *testing.T) {
+	assert.Equal(t, -1.0, Darker(10, 20))
+	assert.Equal(t, -1.0, Darker(110, 2))
+	assert.Equal(t, -1.0, Darker(-10, 2))
+	assert.Equal(t, 0.0, DarkerUnsafe(0, 2))
+	tone := Darker(90, 7)
+	assert.Less(t, tone, 90.0)
+	assert.GreaterOrEqual(t, RatioOfTones(90, tone), 7.0)
+}
@@ -0,0 +1,82 @@
+package contrast
+
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"github.com/gio-eui/md3-colors/hct"
+	colorUtils "github.com/gio-eui/md3-colors/utils/color"
+	"math"
+)
+
+// toneStep is the tone increment used to make up for rounding to 8-bit components.
+const toneStep = 0.1
+
+// Fix returns the opaque color nearest to [foreground] whose contrast ratio against
+// [background] is at least [ratio], such as 4.5 or 7.0.
+//
+// Only the tone of [foreground] changes: its HCT hue is kept, and as much of its chroma as the
+// new tone allows. The tone moves towards whichever of the lighter and darker solutions is
+// closer. [foreground] is returned unchanged, but opaque, if it already meets [ratio]; and
+// black or white, whichever contrasts most with [background], if no color meets [ratio].
+//
+// Alpha is ignored; composite translucent colors over [background] first, see
+// colorUtils.CompositeOver.
+func Fix(foreground, background int, ratio float64) int {
+	foreground = colorUtils.ArgbWithAlpha(foreground, 255)
+	if RatioOfArgbs(foreground, background) >= ratio {
+		return foreground
+	}
+	h := hct.NewHctFromInt(foreground)
+	backgroundTone := colorUtils.LstarFromArgb(background)
+
+	best, bestDistance := 0, math.Inf(1)
+	for _, candidate := range []struct {
+		tone float64
+		step float64
+	}{
+		{Lighter(backgroundTone, ratio), toneStep},
+		{Darker(backgroundTone, ratio), -toneStep},
+	} {
+		if candidate.tone < 0.0 {
+			continue
+		}
+		argb, ok := withToneMeetingRatio(*h, candidate.tone, candidate.step, background, ratio)
+		if distance := math.Abs(candidate.tone - h.GetTone()); ok && distance < bestDistance {
+			best, bestDistance = argb, distance
+		}
+	}
+	if !math.IsInf(bestDistance, 1) {
+		return best
+	}
+
+	if RatioOfArgbs(0xffffffff, background) >= RatioOfArgbs(0xff000000, background) {
+		return 0xffffffff
+	}
+	return 0xff000000
+}
+
+// withToneMeetingRatio sets the tone of [h] to [tone], then moves it by [step] until the
+// color meets [ratio] against [background], which rounding to 8-bit components may require.
+//
+// Returns the color and true, if found; and returns false once the tone leaves 0.0 to 100.0.
+func withToneMeetingRatio(h hct.Hct, tone, step float64, background int, ratio float64) (int, bool) {
+	for ; tone >= 0.0 && tone <= 100.0; tone += step {
+		h.SetTone(tone)
+		if argb := h.ToInt(); RatioOfArgbs(argb, background) >= ratio {
+			return argb, true
+		}
+	}
+	return 0, false
+}
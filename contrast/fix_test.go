@@ -0,0 +1,51 @@
+package contrast
+
+import (
+	"github.com/gio-eui/md3-colors/hct"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestFixKeepsPassingColors(t *testing.T) {
+	assert.Equal(t, 0xff000000, Fix(0xff000000, 0xffffffff, 7))
+	assert.Equal(t, 0xff6750a4, Fix(0x806750a4, 0xffffffff, 4.5))
+}
+
+func TestFixMeetsRatio(t *testing.T) {
+	backgrounds := []int{0xffffffff, 0xff000000, 0xff6750a4, 0xfffef7ff, 0xff1c1b1f, 0xffb3261e, 0xff777777}
+	foregrounds := []int{0xff6750a4, 0xffb3261e, 0xff34a853, 0xfffbbc05, 0xff4285f4, 0xff888888}
+	for _, ratio := range []float64{3, 4.5, 7} {
+		for _, background := range backgrounds {
+			for _, foreground := range foregrounds {
+				fixed := Fix(foreground, background, ratio)
+				black, white := RatioOfArgbs(0xff000000, background), RatioOfArgbs(0xffffffff, background)
+				if black < ratio && white < ratio {
+					continue
+				}
+				assert.GreaterOrEqual(t, RatioOfArgbs(fixed, background), ratio,
+					"%08x on %08x at %v", foreground, background, ratio)
+			}
+		}
+	}
+}
+
+func TestFixKeepsHueAndMovesLeast(t *testing.T) {
+	// Yellow on white only meets 4.5 once darkened; the hue survives.
+	yellow := hct.NewHctFromInt(0xfffbbc05)
+	fixed := hct.NewHctFromInt(Fix(0xfffbbc05, 0xffffffff, 4.5))
+	assert.Less(t, fixed.GetTone(), yellow.GetTone())
+	assert.InDelta(t, yellow.GetHue(), fixed.GetHue(), 2)
+	assert.Greater(t, fixed.GetChroma(), 20.0)
+
+	// A dark purple on a mid-dark background is closer to the darker solution.
+	purple := hct.NewHctFromInt(0xff21005d)
+	fixed = hct.NewHctFromInt(Fix(0xff21005d, 0xff6750a4, 3))
+	assert.Less(t, fixed.GetTone(), purple.GetTone()+1)
+	assert.InDelta(t, purple.GetHue(), fixed.GetHue(), 2)
+}
+
+func TestFixFallsBackToBlackOrWhite(t *testing.T) {
+	// No color has a ratio of 7 against a mid gray.
+	assert.Equal(t, 0xff000000, Fix(0xff6750a4, 0xff777777, 7))
+	assert.Equal(t, 0xffffffff, Fix(0xff6750a4, 0xff000000, 30))
+}
@@ -0,0 +1,185 @@
+// Package gioexport writes Go source files with the colors of Material schemes for Gio
+// applications, so that themes can be committed rather than computed at runtime.
+//
+// The generated files only depend on image/color. For a theme named Brand, a file declares:
+//
+//   - BrandSeed, the seed color;
+//   - BrandPalette, a struct type in the shape of Gio's material.Palette;
+//   - BrandScheme, a struct type with a color.NRGBA field per role of the theme, custom
+//     colors included, and a Palette field of type BrandPalette;
+//   - BrandLight and BrandDark, the light and dark BrandScheme.
+//
+// A Gio application applies a scheme with:
+//
+//	th := material.NewTheme()
+//	th.Palette = material.Palette(theme.BrandLight.Palette)
+package gioexport
+
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/gio-eui/md3-colors/scheme"
+	"github.com/gio-eui/md3-colors/theme"
+	colorUtils "github.com/gio-eui/md3-colors/utils/color"
+	"go/format"
+	"go/token"
+	"image/color"
+	"io"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Theme names a theme.Theme to export.
+type Theme struct {
+	// Name prefixes the declarations of the theme; it must be an exported Go identifier.
+	Name  string
+	Theme *theme.Theme
+}
+
+// Generate returns a gofmt-formatted Go source file of package [pkg] that declares the light
+// and dark schemes of every theme of [themes]. It returns an error if a name does not make a
+// valid exported Go identifier, or if two roles of a theme make the same field name.
+func Generate(pkg string, themes ...Theme) ([]byte, error) {
+	if !token.IsIdentifier(pkg) {
+		return nil, fmt.Errorf("invalid package name %q", pkg)
+	}
+	var b bytes.Buffer
+	b.WriteString("// Code generated by md3gen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package %s\n\nimport \"image/color\"\n", pkg)
+	for _, t := range themes {
+		if !token.IsExported(t.Name) || !token.IsIdentifier(t.Name) {
+			return nil, fmt.Errorf("invalid theme name %q", t.Name)
+		}
+		if err := checkFieldNames(t.Theme); err != nil {
+			return nil, fmt.Errorf("theme %s: %w", t.Name, err)
+		}
+		writeTheme(&b, t.Name, t.Theme)
+	}
+	return format.Source(b.Bytes())
+}
+
+// Write writes the file returned by Generate to [w].
+func Write(w io.Writer, pkg string, themes ...Theme) error {
+	src, err := Generate(pkg, themes...)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(src)
+	return err
+}
+
+// NRGBA converts an ARGB color to a color.NRGBA, as used by Gio.
+func NRGBA(argb int) color.NRGBA {
+	return color.NRGBA{
+		R: uint8(colorUtils.RedFromArgb(argb)),
+		G: uint8(colorUtils.GreenFromArgb(argb)),
+		B: uint8(colorUtils.BlueFromArgb(argb)),
+		A: uint8(colorUtils.AlphaFromArgb(argb)),
+	}
+}
+
+// Palette has the shape of Gio's material.Palette, to which it converts.
+type Palette struct {
+	// Bg is the background color, the surface role.
+	Bg color.NRGBA
+	// Fg is the color of content drawn on Bg, the onSurface role.
+	Fg color.NRGBA
+	// ContrastBg is the color of prominent widgets, the primary role.
+	ContrastBg color.NRGBA
+	// ContrastFg is the color of content drawn on ContrastBg, the onPrimary role.
+	ContrastFg color.NRGBA
+}
+
+// PaletteOf returns the Palette of [s], for applications that compute schemes at runtime:
+//
+//	th.Palette = material.Palette(gioexport.PaletteOf(s))
+func PaletteOf(s *scheme.Scheme) Palette {
+	return Palette{
+		Bg:         NRGBA(s.Surface),
+		Fg:         NRGBA(s.OnSurface),
+		ContrastBg: NRGBA(s.Primary),
+		ContrastFg: NRGBA(s.OnPrimary),
+	}
+}
+
+// writeTheme writes the declarations of [t], prefixed with [name].
+func writeTheme(w io.Writer, name string, t *theme.Theme) {
+	fmt.Fprintf(w, "\n// %sSeed is the seed color of the %s schemes, with the %s variant.\n",
+		name, name, t.Variant)
+	fmt.Fprintf(w, "const %sSeed = 0x%08x\n", name, t.Source)
+
+	fmt.Fprintf(w, "\n// %sPalette has the shape of Gio's material.Palette, to which it converts.\n", name)
+	fmt.Fprintf(w, "type %sPalette struct {\nBg, Fg, ContrastBg, ContrastFg color.NRGBA\n}\n", name)
+
+	fmt.Fprintf(w, "\n// %sScheme holds the colors of a %s scheme, one per role.\n", name, name)
+	fmt.Fprintf(w, "type %sScheme struct {\n", name)
+	for _, role := range t.Roles(false) {
+		fmt.Fprintf(w, "%s color.NRGBA\n", fieldName(role.Name))
+	}
+	fmt.Fprintf(w, "Palette %sPalette\n}\n", name)
+
+	for _, mode := range []struct {
+		name   string
+		isDark bool
+	}{
+		{"Light", false},
+		{"Dark", true},
+	} {
+		fmt.Fprintf(w, "\n// %s%s is the %s scheme of %sSeed.\n",
+			name, mode.name, strings.ToLower(mode.name), name)
+		fmt.Fprintf(w, "var %s%s = %sScheme{\n", name, mode.name, name)
+		for _, role := range t.Roles(mode.isDark) {
+			fmt.Fprintf(w, "%s: %s,\n", fieldName(role.Name), literal(NRGBA(role.Argb)))
+		}
+		palette := PaletteOf(t.Scheme(mode.isDark))
+		fmt.Fprintf(w, "Palette: %sPalette{\n", name)
+		fmt.Fprintf(w, "Bg: %s,\nFg: %s,\nContrastBg: %s,\nContrastFg: %s,\n},\n}\n",
+			literal(palette.Bg), literal(palette.Fg), literal(palette.ContrastBg), literal(palette.ContrastFg))
+	}
+}
+
+// checkFieldNames returns an error if a role of [t] does not make an exported Go field name,
+// or if two roles, or a role and the Palette field, make the same field name.
+func checkFieldNames(t *theme.Theme) error {
+	roles := map[string]string{"Palette": "Palette"}
+	for _, role := range t.Roles(false) {
+		name := fieldName(role.Name)
+		if !token.IsExported(name) || !token.IsIdentifier(name) {
+			return fmt.Errorf("role %q has no exported field name", role.Name)
+		}
+		if other, ok := roles[name]; ok {
+			return fmt.Errorf("roles %q and %q collide as field %s", other, role.Name, name)
+		}
+		roles[name] = role.Name
+	}
+	return nil
+}
+
+// fieldName returns the exported Go field name of a role name such as "onPrimary".
+func fieldName(role string) string {
+	r, size := utf8.DecodeRuneInString(role)
+	if size == 0 {
+		return role
+	}
+	return string(unicode.ToUpper(r)) + role[size:]
+}
+
+// literal returns a color.NRGBA composite literal of [c].
+func literal(c color.NRGBA) string {
+	return fmt.Sprintf("color.NRGBA{R: 0x%02x, G: 0x%02x, B: 0x%02x, A: 0x%02x}", c.R, c.G, c.B, c.A)
+}
@@ -0,0 +1,82 @@
+package gioexport
+
+import (
+	"bytes"
+	"github.com/gio-eui/md3-colors/scheme"
+	"github.com/gio-eui/md3-colors/theme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go/parser"
+	"go/token"
+	"image/color"
+	"testing"
+)
+
+func TestNRGBA(t *testing.T) {
+	assert.Equal(t, color.NRGBA{R: 0x67, G: 0x50, B: 0xa4, A: 0xff}, NRGBA(0xff6750a4))
+	assert.Equal(t, color.NRGBA{R: 0x12, G: 0x34, B: 0x56, A: 0x80}, NRGBA(0x80123456))
+}
+
+func TestPaletteOf(t *testing.T) {
+	s := scheme.NewLightScheme(0xff6750a4)
+	assert.Equal(t, Palette{
+		Bg:         NRGBA(s.Surface),
+		Fg:         NRGBA(s.OnSurface),
+		ContrastBg: NRGBA(0xff6750a4),
+		ContrastFg: NRGBA(0xffffffff),
+	}, PaletteOf(s))
+}
+
+func TestGenerate(t *testing.T) {
+	warning := scheme.CustomColor{Name: "warning", Value: 0xfffbbc05, Blend: true}
+	brand, err := theme.NewThemeFromSourceColor(0xff6750a4, warning)
+	require.NoError(t, err)
+	photo, err := theme.NewTheme(0xff00796b, scheme.VariantContent)
+	require.NoError(t, err)
+	src, err := Generate("theme", Theme{Name: "Brand", Theme: brand}, Theme{Name: "Photo", Theme: photo})
+	require.NoError(t, err)
+
+	file, err := parser.ParseFile(token.NewFileSet(), "theme.gen.go", src, parser.ParseComments)
+	require.NoError(t, err)
+	assert.Equal(t, "theme", file.Name.Name)
+	for _, name := range []string{"BrandSeed", "BrandPalette", "BrandScheme", "BrandLight", "BrandDark", "PhotoDark"} {
+		assert.NotNil(t, file.Scope.Lookup(name), name)
+	}
+
+	dark := scheme.NewDarkScheme(0xff6750a4)
+	assert.Contains(t, string(src), "// BrandSeed is the seed color of the Brand schemes, with the default variant.")
+	assert.Contains(t, string(src), "ContrastBg: "+literal(NRGBA(dark.Primary)))
+	assert.Contains(t, string(src), "OnPrimaryContainer:      "+literal(NRGBA(dark.OnPrimaryContainer)))
+	assert.Contains(t, string(src), "OnWarningContainer:      "+literal(NRGBA(brand.CustomColors[0].Dark.OnColorContainer)))
+	assert.Contains(t, string(src), "with the content variant")
+
+	var b bytes.Buffer
+	require.NoError(t, Write(&b, "theme", Theme{Name: "Brand", Theme: brand}))
+	assert.True(t, bytes.HasPrefix(b.Bytes(), []byte("// Code generated by md3gen. DO NOT EDIT.")))
+}
+
+func TestGenerateRejectsInvalidNames(t *testing.T) {
+	brand, err := theme.NewThemeFromSourceColor(0xff6750a4)
+	require.NoError(t, err)
+	_, err = Generate("theme", Theme{Name: "brand", Theme: brand})
+	assert.Error(t, err)
+	_, err = Generate("my-theme", Theme{Name: "Brand", Theme: brand})
+	assert.Error(t, err)
+}
+
+func TestGenerateRejectsInvalidRoleNames(t *testing.T) {
+	// Themes built by hand bypass the validation of theme.NewTheme.
+	for _, name := range []string{"", "1st", "primary", "palette"} {
+		brand, err := theme.NewThemeFromSourceColor(0xff6750a4)
+		require.NoError(t, err)
+		brand.CustomColors = append(brand.CustomColors, &scheme.CustomColorGroup{Color: scheme.CustomColor{Name: name}})
+		_, err = Generate("theme", Theme{Name: "Brand", Theme: brand})
+		assert.Error(t, err, name)
+	}
+
+	brand, err := theme.NewThemeFromSourceColor(0xff6750a4, scheme.CustomColor{Name: "ärger", Value: 0xfffbbc05})
+	require.NoError(t, err)
+	src, err := Generate("theme", Theme{Name: "Brand", Theme: brand})
+	require.NoError(t, err)
+	assert.Contains(t, string(src), "OnÄrgerContainer ")
+}
@@ -0,0 +1,66 @@
+package hct
+
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	colorUtils "github.com/gio-eui/md3-colors/utils/color"
+)
+
+// The batch conversions below process whole pixel buffers, such as the pixels of an image,
+// into buffers owned by the caller. They do not allocate per pixel, so buffers can be reused
+// across frames. [dst] must be at least as long as [src]; alpha does not affect the colors.
+
+// Cam16sFromArgbs converts every ARGB color of [src] to CAM16, assuming default viewing
+// conditions, and stores the results in [dst].
+func Cam16sFromArgbs(dst []Cam16, src []uint32) {
+	_ = dst[:len(src)]
+	for i, argb := range src {
+		dst[i] = Cam16FromInt(int(argb))
+	}
+}
+
+// HctsFromArgbs converts every ARGB color of [src] to HCT and stores the results in [dst].
+// Like NewHctFromInt, it keeps the alpha of the ARGB colors.
+func HctsFromArgbs(dst []Hct, src []uint32) {
+	_ = dst[:len(src)]
+	for i, argb := range src {
+		xyz := colorUtils.XyzFromArgb(int(argb))
+		cam := Cam16FromXyzInViewingConditions(xyz[0], xyz[1], xyz[2], DefaultViewingConditions)
+		dst[i] = Hct{
+			hue:    cam.GetHue(),
+			chroma: cam.GetChroma(),
+			tone:   colorUtils.LstarFromY(xyz[1]),
+			argb:   int(argb),
+		}
+	}
+}
+
+// ArgbsFromHcts stores the ARGB representation of every HCT color of [src] in [dst].
+func ArgbsFromHcts(dst []uint32, src []Hct) {
+	_ = dst[:len(src)]
+	for i := range src {
+		dst[i] = uint32(src[i].argb)
+	}
+}
+
+// ArgbsFromCam16s converts every CAM16 color of [src], viewed in default viewing conditions,
+// to ARGB and stores the results in [dst].
+func ArgbsFromCam16s(dst []uint32, src []Cam16) {
+	_ = dst[:len(src)]
+	for i := range src {
+		xyz := src[i].xyzInViewingConditions(&DefaultViewingConditions)
+		dst[i] = uint32(colorUtils.ArgbFromXyz(xyz[0], xyz[1], xyz[2]))
+	}
+}
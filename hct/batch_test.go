@@ -0,0 +1,98 @@
+package hct
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// frame4K returns the pixels of a 3840x2160 test image.
+func frame4K() []uint32 {
+	return testPixels(3840 * 2160)
+}
+
+// testPixels returns [n] pseudo-random opaque pixels.
+func testPixels(n int) []uint32 {
+	pixels := make([]uint32, n)
+	for i := range pixels {
+		pixels[i] = 0xff000000 | uint32(i*2654435761)&0xffffff
+	}
+	return pixels
+}
+
+func TestBatchConversionsMatchSingleConversions(t *testing.T) {
+	src := []uint32{0xff000000, 0xffffffff, 0xffff0000, 0xff00ff00, 0xff0000ff, 0xff6750a4, 0x806750a4, 0x00ffffff}
+
+	hcts := make([]Hct, len(src))
+	HctsFromArgbs(hcts, src)
+	cams := make([]Cam16, len(src))
+	Cam16sFromArgbs(cams, src)
+	argbs := make([]uint32, len(src))
+	for i, argb := range src {
+		expected := NewHctFromInt(int(argb))
+		assert.Equal(t, expected.ToInt(), hcts[i].ToInt())
+		assert.InDelta(t, expected.GetHue(), hcts[i].GetHue(), 1e-9)
+		assert.InDelta(t, expected.GetChroma(), hcts[i].GetChroma(), 1e-9)
+		assert.InDelta(t, expected.GetTone(), hcts[i].GetTone(), 1e-9)
+		assert.Equal(t, Cam16FromInt(int(argb)), cams[i])
+	}
+
+	ArgbsFromHcts(argbs, hcts)
+	for i, argb := range src {
+		assert.Equal(t, argb, argbs[i])
+	}
+	ArgbsFromCam16s(argbs, cams)
+	for i := range src {
+		assert.Equal(t, uint32(cams[i].ToInt()), argbs[i])
+	}
+}
+
+func TestBatchConversionsDoNotAllocate(t *testing.T) {
+	src := testPixels(1024)
+	hcts := make([]Hct, len(src))
+	cams := make([]Cam16, len(src))
+	argbs := make([]uint32, len(src))
+
+	assert.Zero(t, testing.AllocsPerRun(5, func() { HctsFromArgbs(hcts, src) }))
+	assert.Zero(t, testing.AllocsPerRun(5, func() { Cam16sFromArgbs(cams, src) }))
+	assert.Zero(t, testing.AllocsPerRun(5, func() { ArgbsFromHcts(argbs, hcts) }))
+	assert.Zero(t, testing.AllocsPerRun(5, func() { ArgbsFromCam16s(argbs, cams) }))
+}
+
+func TestBatchConversionsPanicOnShortDestination(t *testing.T) {
+	assert.Panics(t, func() { HctsFromArgbs(make([]Hct, 1), []uint32{0xff000000, 0xffffffff}) })
+}
+
+func BenchmarkHctsFromArgbs4K(b *testing.B) {
+	src := frame4K()
+	dst := make([]Hct, len(src))
+	b.SetBytes(int64(4 * len(src)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		HctsFromArgbs(dst, src)
+	}
+}
+
+func BenchmarkCam16sFromArgbs4K(b *testing.B) {
+	src := frame4K()
+	dst := make([]Cam16, len(src))
+	b.SetBytes(int64(4 * len(src)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Cam16sFromArgbs(dst, src)
+	}
+}
+
+func BenchmarkArgbsFromCam16s4K(b *testing.B) {
+	src := frame4K()
+	cams := make([]Cam16, len(src))
+	Cam16sFromArgbs(cams, src)
+	dst := make([]uint32, len(src))
+	b.SetBytes(int64(4 * len(src)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ArgbsFromCam16s(dst, cams)
+	}
+}
@@ -66,21 +66,21 @@ type Cam16 struct {
 }
 
 // XYZToCam16RGB transforms XYZ color space coordinates to 'cone'/'RGB' responses in CAM16.
-var XYZToCam16RGB = [][]float64{
+var XYZToCam16RGB = [3][3]float64{
 	{0.401288, 0.650173, -0.051461},
 	{-0.250268, 1.204414, 0.045854},
 	{-0.002079, 0.048952, 0.953127},
 }
 
 // CAM16RGBToXYZ transforms 'cone'/'RGB' responses in CAM16 to XYZ color space coordinates.
-var CAM16RGBToXYZ = [][]float64{
+var CAM16RGBToXYZ = [3][3]float64{
 	{1.8620678, -1.0112547, 0.14918678},
 	{0.38752654, 0.62144744, -0.00897398},
 	{-0.01584150, -0.03412294, 1.0499644},
 }
 
-// distance calculates the color distance between two CAM16 instances.
-func (c *Cam16) distance(other *Cam16) float64 {
+// Distance calculates the color difference between two CAM16 instances, ΔE in CAM16-UCS.
+func (c *Cam16) Distance(other *Cam16) float64 {
 	dJ := c.GetJstar() - other.GetJstar()
 	dA := c.GetAstar() - other.GetAstar()
 	dB := c.GetBstar() - other.GetBstar()
@@ -304,11 +304,23 @@ func (c *Cam16) ToInt() int {
 }
 
 func (c *Cam16) Viewed(viewingConditions ViewingConditions) int {
-	xyz := c.XyzInViewingConditions(viewingConditions, nil)
+	xyz := c.xyzInViewingConditions(&viewingConditions)
 	return colorUtils.ArgbFromXyz(xyz[0], xyz[1], xyz[2])
 }
 
 func (c *Cam16) XyzInViewingConditions(viewingConditions ViewingConditions, returnArray []float64) []float64 {
+	xyz := c.xyzInViewingConditions(&viewingConditions)
+	if returnArray != nil {
+		returnArray[0] = xyz[0]
+		returnArray[1] = xyz[1]
+		returnArray[2] = xyz[2]
+		return returnArray
+	} else {
+		return []float64{xyz[0], xyz[1], xyz[2]}
+	}
+}
+
+func (c *Cam16) xyzInViewingConditions(viewingConditions *ViewingConditions) [3]float64 {
 	alpha := 0.0
 	if c.GetChroma() != 0.0 && c.GetJ() != 0.0 {
 		alpha = c.GetChroma() / math.Sqrt(c.GetJ()/100.0)
@@ -342,17 +354,5 @@ func (c *Cam16) XyzInViewingConditions(viewingConditions ViewingConditions, retu
 	gF := gC / viewingConditions.GetRgbD()[1]
 	bF := bC / viewingConditions.GetRgbD()[2]
 
-	matrix := CAM16RGBToXYZ
-	x := rF*matrix[0][0] + gF*matrix[0][1] + bF*matrix[0][2]
-	y := rF*matrix[1][0] + gF*matrix[1][1] + bF*matrix[1][2]
-	z := rF*matrix[2][0] + gF*matrix[2][1] + bF*matrix[2][2]
-
-	if returnArray != nil {
-		returnArray[0] = x
-		returnArray[1] = y
-		returnArray[2] = z
-		return returnArray
-	} else {
-		return []float64{x, y, z}
-	}
+	return mathUtils.MatrixMultiply([3]float64{rF, gF, bF}, &CAM16RGBToXYZ)
 }
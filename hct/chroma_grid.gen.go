@@ -0,0 +1,1158 @@
+// Code generated by md3gen. DO NOT EDIT.
+
+package hct
+
+func init() {
+	defaultChromaGridSamples = generatedChromaGridSamples[:]
+}
+
+var generatedChromaGridSamples = [9180]float64{
+	0.0, 26.323955287550017, 32.7179246333724, 36.988570333878926, 40.36798194571831, 43.37434441328603, 46.33779389689877, 49.1945877981765,
+	52.08189260865339, 54.93557203334095, 57.69217013630149, 60.42088671339914, 63.18460644451897, 65.85972106234381, 68.5107563722515, 71.19850327496623,
+	73.80319976576602, 76.38673375206967, 78.94987637537659, 81.49279512707788, 84.01781241068764, 86.52389802795474, 89.01218351861073, 91.4832100474045,
+	93.93777272465815, 96.32204124511892, 98.7445142097968, 101.14449509520065, 99.32111040305087, 91.88056669406718, 84.97841189817812, 78.52479849488196,
+	72.47231418339928, 66.7575865691705, 61.34462664274273, 56.23992287821482, 51.36808036922324, 46.71855791869994, 42.26824413241771, 37.99627550646949,
+	33.83255872439379, 29.91235630525384, 26.065790276037074, 22.593904967426496, 18.95136322603778, 15.381156766287134, 11.861718555029313, 8.660480271228742,
+	5.135149455366703, 1.7998693816502804, 0.0, 0.0, 26.40902787070223, 32.79198041127123, 37.14349064477363, 40.517236904059644,
+	43.522675600297646, 46.485610909289626, 49.40948902303008, 52.289465545510055, 55.15145492361042, 57.8988896547406, 60.69235579256213, 63.44708024104264,
+	66.12483806504748, 68.83915236417945, 71.44573616032635, 74.12676913495295, 76.70364759853985, 79.26095876785352, 81.79928904880794, 84.38417984076789,
+	86.88512449718623, 89.36880130072691, 91.83567574053836, 94.27965378257623, 96.72076163100188, 99.13978838467493, 101.5591911491437, 98.47486606732024,
+	90.96918227446307, 84.09648788647377, 77.56454528946065, 71.47211682209523, 65.83975882547739, 60.394720113350466, 55.231963809571106, 50.52348706907726,
+	45.84565655769045, 41.58761771522633, 37.29796772336115, 33.16972672910744, 29.420901246933212, 25.566381752607874, 22.070119916124764, 18.421041858757057,
+	15.107312782732375, 11.861718555029313, 8.36490315335818, 5.135149455366703, 1.7998693816502804, 0.0, 0.0, 26.521642493066555,
+	32.96683141957357, 37.30844444462441, 40.69147062507187, 43.69301809196886, 46.65301398657248, 49.57462309391247, 52.5483462626481, 55.39982558264102,
+	58.1350018740875, 60.92738168885758, 63.69213742349511, 66.43072342351664, 69.14443722933379, 71.83444181107818, 74.42257317874828, 77.06852757635589,
+	79.61689738528058, 82.222070644943, 84.73318090871733, 87.30036007431583, 89.77641721028928, 92.30799069471522, 94.75093698845104, 97.17860141149842,
+	99.59129883057007, 102.05749149076152, 97.79063753161422, 90.26002406908415, 83.17403849475338, 76.69465569759036, 70.65202446970687, 64.95037374067611,
+	59.50919485235631, 54.48839765026543, 49.73999758096159, 45.23535658565045, 40.746072419871645, 36.647342551526265, 32.7239427925809, 28.95696876992487,
+	25.12827612401231, 21.584436237422302, 18.173449312881893, 14.84826935305151, 11.587913001657972, 8.36490315335818, 5.135149455366703, 1.4702780756726934,
+	0.0, 0.0, 26.670908946932396, 33.067983850442346, 37.40677716290145, 40.891440941489925, 43.88599722870484, 46.94194437437842,
+	49.85619474805107, 52.7361711936194, 55.681664496509285, 58.49705573451067, 61.284089842006765, 64.04421459975049, 66.77874022053783, 69.4888536036084,
+	72.17563183369036, 74.84005450316099, 77.48301473247862, 80.10532891261013, 82.6223509864027, 85.20624202323845, 87.77151112773464, 90.23678992365916,
+	92.76703392653731, 95.28027809026315, 97.69772838272078, 100.17873063182296, 102.55882500568681, 97.19693831412127, 89.54746784750975, 82.48082264240867,
+	76.04302934883194, 69.93972539417547, 64.22743017351986, 58.861595588919045, 53.8039249298945, 49.02117023410704, 44.48418729567191, 40.15857573437465,
+	36.04704605259593, 32.10288222711322, 28.485506723046072, 24.87869298334243, 21.338972484178576, 17.938128239394086, 14.60468320373217, 11.334679522511522,
+	8.07639925821602, 4.8510346941034435, 1.4702780756726934, 0.0, 0.0, 26.670908946932396, 33.30297995826273, 37.626200726969465,
+	41.11766238636486, 44.15370417685772, 47.16030879791212, 50.17931138135596, 53.04141852980937, 55.99764690893548, 58.80484938788992, 61.60337785254481,
+	64.44294983051097, 67.16987673167104, 69.87326470562313, 72.55404119451026, 75.31179495622564, 77.94829212043982, 80.56471683325007, 83.16173349923206,
+	85.7399606244265, 88.29997437775151, 90.80612065244725, 93.3674750396222, 95.78673769790623, 98.27977659653303, 100.75691178243964, 103.21853456099477,
+	96.69999991491191, 89.03265013772979, 81.91322218708422, 75.35370243153288, 69.30277274887054, 63.541457568325356, 58.270684986440415, 53.18136517065056,
+	48.52649815219302, 43.96991333870665, 39.635329391126625, 35.6760469693899, 31.721941667048377, 27.925753096302643, 24.463574346839565, 20.932356348125698,
+	17.505891462699644, 14.377184640200639, 11.103121553642175, 8.07639925821602, 4.8510346941034435, 1.4702780756726934, 0.0, 0.0,
+	26.836920856585923, 33.446313011184756, 37.876217254277584, 41.370275799744526, 44.46987812363394, 47.52676473333623, 50.418004084352, 53.4000278333148,
+	56.34798450562736, 59.144014311074706, 62.03058874743196, 64.88875949097782, 67.60453794617567, 70.41054053905361, 73.07987902947261, 75.72863523168607,
+	78.46482969675422, 81.07219697824478, 83.66094457380403, 86.33501117417161, 88.88635074907651, 91.42066092408982, 93.93836486005267, 96.43986463290767,
+	98.92554197008579, 101.39575913522091, 103.85085989289982, 96.35591501967217, 88.54900111965543, 81.38091542867971, 74.76992277015593, 68.64238189931305,
+	62.94066873162285, 57.615112203710936, 52.62329575493563, 47.92866675148246, 43.45752267981285, 39.15240216079507, 35.165070064636936, 31.36849605887312,
+	27.56776121344184, 24.084909470507746, 20.736452818625484, 17.309722444219535, 14.166368946248088, 10.894261321833465, 7.866186390613845, 4.8510346941034435,
+	1.4702780756726934, 0.0, 0.0, 27.14433461347948, 33.61311593653317, 38.15687138716039, 41.648880528581614, 44.74384801543463,
+	47.8162091730971, 50.81054962021692, 53.788273022113025, 56.73222284026614, 59.644479231083544, 62.52686553108016, 65.38099036336801, 68.08227648614614,
+	70.86021814401572, 73.66377243086944, 76.29850881513748, 79.03205471600704, 81.72319967010932, 84.31910869716796, 86.97269505952754, 89.53007568135055,
+	92.05331399303554, 94.66939990849055, 97.15952408875128, 99.74094740315948, 102.19958165287332, 104.64381520942221, 96.11478906688504, 88.23787680980172,
+	81.0074620913198, 74.36694334841994, 68.18186989665837, 62.52351959692163, 57.15946517747841, 52.131999998742906, 47.40389885065699, 42.94275157738154,
+	38.72007575603547, 34.710591050683405, 30.891605792185228, 27.24247349023866, 23.74407681912894, 20.37828625230625, 17.127311088950254, 13.913763682025383,
+	10.894261321833465, 7.667454009747838, 4.627144318193704, 1.4702780756726934, 0.0, 0.0, 27.14433461347948, 33.80884726136935,
+	38.488007136685184, 41.952322134041076, 45.03986106691269, 48.23804546862829, 51.22829049065332, 54.21235607697487, 57.1489862426973, 60.05459532801375,
+	62.930890821616686, 65.9174658123336, 68.7373793415348, 71.53222590507416, 74.17100112853953, 76.92058165549315, 79.64793295595075, 82.3538785170648,
+	85.03917215787337, 87.70450619459419, 90.22894939078684, 92.85742359595788, 95.46766560691312, 97.94325560953267, 100.5195360115783, 103.07898475124652,
+	104.83273030330585, 96.00186134130328, 87.99801579846552, 80.69249242871645, 73.98616962316048, 67.80529482353043, 62.08363537481643, 56.76674902819619,
+	51.70941710774984, 47.05970155157793, 42.579721728673576, 38.46070380607612, 34.43991679809513, 30.61022208171017, 26.971992044970346, 23.442292169451353,
+	20.216453994286073, 16.958965918043152, 13.796939913419283, 10.709013592457676, 7.667454009747838, 4.627144318193704, 1.4702780756726934, 0.0,
+	0.0, 27.532955020308215, 34.32023500846324, 38.68470926345799, 42.28467848796824, 45.521412288058656, 48.55458207963635, 51.71082816805575,
+	54.66914078264782, 57.75052913185406, 60.64353681070997, 63.508372163425186, 66.49391797639034, 69.30352799978621, 72.08885163608052, 74.85086471890486,
+	77.72957803054132, 80.44538052555176, 83.1404668867398, 85.81551447940771, 88.47115012927891, 91.10795531441026, 93.72647068958497, 96.32720005063919,
+	98.9106138234106, 101.4771521457635, 104.02722759903045, 105.01841647644028, 96.01009596771368, 87.89850064153191, 80.50343309158661, 73.7558065685733,
+	67.51413784912786, 61.73618569725863, 56.36626900164692, 51.35709573010278, 46.66816386929842, 42.26453001925916, 38.11585251309399, 34.10743743355409,
+	30.358779069505285, 26.69346978898404, 23.29336761747691, 19.92823035736513, 16.804963432099576, 13.639264342797423, 10.548160297954013, 7.504869623864184,
+	4.627144318193704, 1.4702780756726934, 0.0, 0.0, 27.532955020308215, 34.662501213672684, 39.162920089306894, 42.68114906833432,
+	45.84600654849134, 49.05698833993414, 52.20810126779936, 55.319661335184605, 58.23019262670228, 61.272227234690334, 64.12318452065254, 67.12267178922487,
+	70.05390732100892, 72.82491927981495, 75.72220221802286, 78.44665849899819, 81.15045246545296, 83.9763624580784, 86.63851076952801, 89.42054209021731,
+	92.04375203426096, 94.64936770931789, 97.23783465110283, 99.94119935729125, 102.49487444787535, 105.03265539496275, 105.34265138681309, 96.16607875746921,
+	87.90470713838354, 80.42113217288414, 73.58423074794582, 67.30916268324668, 61.525615014502165, 56.11927756202988, 51.1396529377759, 46.42586574836372,
+	41.99796625420051, 37.824960437127, 33.87960810155951, 30.1377752253106, 26.47115600161444, 23.065020169646, 19.756199618212467, 16.54091455749316,
+	13.500131501288223, 10.412327565642194, 7.504869623864184, 4.469193260819624, 1.4702780756726934, 0.0, 0.0, 28.123917888579243,
+	34.662501213672684, 39.45800673382665, 43.17203860870845, 46.50711992723465, 49.60627212419421, 52.7248842538188, 55.82776718249003, 58.89437482448553,
+	61.926487389943446, 64.9260771934414, 67.89488442733642, 70.67707970310592, 73.59113828800058, 76.51956358192234, 79.34028699046415, 82.17752343490316,
+	84.8448091730347, 87.63758267176765, 90.40830858470576, 93.0171037881233, 95.74756994404997, 98.32087233919131, 101.01333888136878, 103.55299959826988,
+	106.2094307993878, 105.78977028603306, 96.42302423555063, 88.03826189566453, 80.44235782846685, 73.52806424753832, 67.1903389686694, 61.34699741024202,
+	55.93800930108575, 50.91669854724515, 46.169184122829506, 41.78059998580142, 37.58879379856295, 33.62489772747704, 29.86418040526168, 26.284327492047677,
+	22.864883174334118, 19.586687285726946, 16.431235533941088, 13.379837433481091, 10.412327565642194, 7.379667032678804, 4.469193260819624, 1.4702780756726934,
+	0.0, 0.0, 28.123917888579243, 35.09321604985119, 39.804041079301854, 43.799583995557434, 47.04689433717962, 50.0435660475309,
+	53.30103810128252, 56.53213000742945, 59.564796645098895, 62.58562350958014, 65.73478476019321, 68.69106267365704, 71.5888784523508, 74.51841552295204,
+	77.39212719354234, 80.24072449794691, 83.06521377043978, 85.86650842815342, 88.6454415260511, 91.40277609111362, 94.13921372086358, 96.85540180585554,
+	99.54683053048112, 102.22938367877802, 104.8882519504224, 107.52902800790987, 106.48474326016331, 96.83935031750349, 88.29117262529458, 80.56349882465956,
+	73.56272910397031, 67.15677240980669, 61.26195314793554, 55.816870466226696, 50.75793601567492, 46.03394214620978, 41.56785842716996, 37.40782188905891,
+	33.48949625038668, 29.720772086444967, 26.133309866680495, 22.780425868379215, 19.497474762395726, 16.336629824644497, 13.278598458343646, 10.322814367662776,
+	7.379667032678804, 4.469193260819624, 1.4702780756726934, 0.0, 0.0, 28.830381672822703, 35.574169986242204, 40.2172985036272,
+	44.18670089814449, 47.364801546987074, 50.86031396488138, 54.01263219450908, 57.16280317724569, 60.30101087540729, 63.4405845908606, 66.52164579991592,
+	69.59895797991543, 72.51267080160738, 75.54229372604215, 78.40570761110584, 81.37826078753305, 84.19099792508037, 86.9802791568189, 89.87703074003427,
+	92.6213308302814, 95.47087676512739, 98.17319895059106, 100.85592186769271, 103.51965861361147, 106.28583789601878, 108.91231763972557, 107.26918731440249,
+	97.43501134110298, 88.66287949496387, 80.82353585011093, 73.69952931483904, 67.20664299070113, 61.26039464046419, 55.769732650769846, 50.67514444555758,
+	45.919345754105194, 41.46618995609245, 37.282207242972305, 33.34414538992718, 29.597928101544035, 26.024326242512096, 22.642911330735686, 19.356346948209858,
+	16.245711750549642, 13.19654745615145, 10.217340226240443, 7.292530315843685, 4.469193260819624, 1.4702780756726934, 0.0, 0.0,
+	28.830381672822703, 36.217484589270775, 40.723387854061414, 44.642565212942486, 48.13583433596603, 51.561833682884924, 54.819971231829, 57.93630723899525,
+	61.180196688374615, 64.23162363839948, 67.4433049785588, 70.61004628912075, 73.56388127232744, 76.53385063602586, 79.58194560366678, 82.48886729033913,
+	85.3812905091165, 88.257686770137, 91.11704745658679, 93.9585345087223, 96.7795374301738, 99.57814527914351, 102.35483403660112, 105.11027473514999,
+	107.75930237351945, 110.47539981470945, 108.2089725824673, 98.12055490529781, 89.17353450049755, 81.19927329445377, 73.94777822153875, 67.37261331607904,
+	61.33990231495202, 55.79974495085686, 50.66357855267864, 45.884102202909716, 41.413915062467375, 37.211802088345976, 33.27191415383639, 29.52801007523123,
+	25.93919801703552, 22.547319095081804, 19.304458863843696, 16.143819775318697, 13.133731809401581, 10.158514750845038, 7.292530315843685, 4.380342951315611,
+	1.4702780756726934, 0.0, 0.0, 28.466897144812403, 35.79023402460448, 40.00658152329011, 43.85825825855553, 47.198952965240906,
+	50.963287935492524, 53.70501247666849, 57.122462582052705, 60.03636062922779, 63.17590322991313, 65.97913518605615, 69.30486529782736, 72.14456472686531,
+	75.37662637261624, 78.2065975059985, 81.04609734795231, 83.9956944729512, 86.72957085324391, 89.70449446850203, 92.62303803158753, 95.41605636383906,
+	98.18827926094559, 100.89345700040879, 103.67096819277586, 106.38166669025995, 109.07508598025169, 109.38840473509023, 98.99469066758539, 89.8631092409782,
+	81.67385381917602, 74.28353353545405, 67.59475677273778, 61.50592544492569, 55.92508337866726, 50.721972136393354, 45.908038246093334, 41.41057144340666,
+	37.19615108779127, 33.22396497353235, 29.465491997812407, 25.89594110199624, 22.493499020907453, 19.237914467756205, 16.10985506515797, 13.090113084102153,
+	10.158514750845038, 7.243551391362446, 4.380342951315611, 1.3955942916957362, 0.0, 0.0, 26.52361333831128, 32.68732938276654,
+	37.3687188644273, 40.85846991759971, 43.646534742191534, 46.9410567637587, 49.831030873545565, 52.48233876358806, 55.763574063125645, 58.43064009838054,
+	61.16469162402464, 64.35826149343876, 66.9984680349749, 69.79110787592431, 72.23723677102022, 74.83678088410198, 77.96370452888132, 80.53157017608412,
+	83.12903683893148, 85.78201112646171, 88.11107413021496, 90.8798796094664, 93.33252187257918, 96.16457192684393, 98.64288108569814, 100.94713041604697,
+	103.56544603700922, 100.044552557074, 90.60668018656892, 82.30985409674693, 74.77805661665687, 67.9543273628065, 61.75503312198441, 56.09317812615765,
+	50.848541976154365, 46.007242692367576, 41.48099109313673, 37.234500063614064, 33.2371683150697, 29.46248434746379, 25.88817553730549, 22.482140287835215,
+	19.223136582858636, 16.090919540031155, 13.0655684569726, 10.125359961948886, 7.243551391362446, 4.380342951315611, 1.3955942916957362, 0.0,
+	0.0, 24.765614149284136, 30.658492392395196, 34.743072894676274, 37.74975580711292, 40.80106159325602, 43.98311356994785, 46.505847034020796,
+	49.42064945660183, 51.90563965259175, 54.127257896275374, 56.89363754268543, 59.6209905765144, 62.34636550526628, 65.05725628855902, 67.52858084900915,
+	69.90800599586466, 72.40808751795858, 75.10177656370237, 77.5700072536668, 79.70520443689364, 82.36109892222294, 84.96788946396362, 86.8751743129096,
+	89.37148286364783, 91.80975596176297, 94.19285114792717, 96.68372765035019, 98.6489919673732, 91.57020443125792, 83.00884092830005, 75.36332487504272,
+	68.40505068590691, 62.15559827218809, 56.38395580660419, 51.041000298580244, 46.17266961954084, 41.58872069104095, 37.32581011639853, 33.27941493951901,
+	29.489993227630247, 25.915430239840447, 22.49017186904245, 19.22438787823673, 16.086876578066917, 13.059578752769104, 10.117555008583833, 7.232229468749799,
+	4.360427564382008, 1.3955942916957362, 0.0, 0.0, 22.802132558134907, 27.808382513085586, 33.077165200162526, 35.58857097354508,
+	38.20864730090608, 40.99423072942619, 43.56884228659242, 46.03636229519304, 48.64689719120904, 51.52976296474926, 53.356755782109204, 56.15639881185912,
+	58.253713066583295, 61.05213101208647, 63.04840530965348, 65.66293476484957, 68.0772818373677, 70.62759680490572, 72.52469622513614, 74.76239749293015,
+	77.14199354254866, 79.37233204663461, 81.54579378302007, 84.1342474580024, 85.94571176747498, 88.07624402098111, 90.12427583771525, 92.56155465958425,
+	92.7478340532969, 83.92412034566806, 76.11126598272516, 69.02862080672989, 62.56642385298053, 56.75881171288246, 51.36995887173572, 46.35766689964422,
+	41.74219927936319, 37.468776315310656, 33.39191388349408, 29.58741771382605, 25.977093347228212, 22.538572101581583, 19.251562311148987, 16.0975504300449,
+	13.072808364746262, 10.134600992884861, 7.232229468749799, 4.362206865175413, 1.3955942916957362, 0.0, 0.0, 21.185779737014,
+	26.799566690623745, 29.890088988666697, 33.362512084612966, 36.20967931514103, 38.965064502085376, 41.07352277258205, 43.77509224575671, 45.687432883361566,
+	48.644852105715174, 50.55759419010348, 52.78493914909463, 55.090152734843926, 57.32763036218793, 59.48546590851746, 61.496939417732854, 64.13684375642076,
+	66.14332446197974, 68.53662923160151, 70.50563863847599, 72.44169647716978, 74.68533782714135, 76.59228683891583, 78.74575904791736, 80.97523338768475,
+	83.16037569583902, 84.90073034843606, 86.96767288525182, 89.03538522126033, 84.96134339185188, 76.90248585937854, 69.7341729801869, 63.14036372026972,
+	57.210812751435114, 51.69982277880621, 46.653799539584, 42.01555316429164, 37.66184997767044, 33.5618555382654, 29.688955450657623, 26.072424117227637,
+	22.626410598807134, 19.329703185116983, 16.162164831578334, 13.10396057806501, 10.134600992884861, 7.257512180170703, 4.362206865175413, 1.3955942916957362,
+	0.0, 0.0, 20.33067543455014, 25.994231735038692, 28.374812647624864, 31.979113575516422, 33.600226465963516, 36.53195050625877,
+	38.58384584234718, 41.31207112670917, 43.294434099965464, 45.76070186219446, 47.61664001701322, 49.48564527712002, 52.36439506355878, 54.566286997360805,
+	56.274286821329056, 58.44029212961536, 60.55333640963983, 62.61665593231245, 64.63325408169847, 67.06104565432817, 69.06160260636963, 70.94585268540338,
+	72.35199740701238, 74.60480851102035, 76.45287224804217, 78.69043512202256, 80.87458116153944, 82.14697428002818, 84.27380939619474, 86.20099769384318,
+	77.88518987662209, 70.49390259538995, 63.78826015668807, 57.73203190488514, 52.18933078066968, 47.01519308585477, 42.27001896141724, 37.90326347217141,
+	33.78727109107686, 29.894430882884908, 26.20056640363267, 22.684779095993548, 19.38599795652931, 16.215581754252764, 13.15293515264279, 10.175838828476087,
+	7.257512180170703, 4.406921010605381, 1.3955942916957362, 0.0, 0.0, 19.364445671330316, 24.225249361568725, 26.9168021705569,
+	29.985640167790663, 32.30758446751047, 34.56653299508666, 37.345184282836804, 38.848629373381264, 41.46478406781292, 43.42886260840515, 45.33760412180931,
+	47.22184229158336, 49.5691170461782, 51.35231166925512, 53.585528737373565, 55.79020416563874, 57.44441620515782, 59.55710133214716, 61.11211048944432,
+	63.165833598678965, 65.15510176500524, 67.56642862550017, 69.01633708550933, 70.91841135044677, 73.20543802998142, 74.57199708571281, 76.37730817195897,
+	78.12834334367959, 80.29957824238082, 82.01614070406319, 79.06873853782349, 71.50083143369567, 64.61487156071526, 58.436332674086394, 52.756854861652755,
+	47.55241323055299, 42.67182893575075, 38.191056472973, 34.06593794133661, 30.06573313260549, 26.360563191152558, 22.84526633933161, 19.531984273720724,
+	16.27854324650937, 13.219260767442446, 10.240471107495452, 7.317871662221335, 4.406921010605381, 1.3955942916957362, 0.0, 0.0,
+	17.97615031110421, 23.249752612266686, 26.185043882143248, 28.63618022285878, 31.01444786951768, 32.65507953362719, 34.88138060250345, 37.031559038508156,
+	39.10626228061064, 41.121246048368675, 43.08153013142782, 44.972061740502944, 47.388300889592124, 49.18452484579788, 51.4837662704812, 52.6884834296653,
+	54.885717643980676, 57.035047128891556, 58.15827420579452, 60.218394194827624, 62.25527364873336, 63.78678914201216, 65.73951103783925, 67.6705602071321,
+	69.56652256442636, 70.97971426450724, 72.80851973322284, 74.619344950086, 76.392001846528, 78.14708047694324, 79.8677911174452, 72.58175863102952,
+	65.48928395317135, 59.210603118173836, 53.393274272248725, 48.045038331759905, 43.14081936641715, 38.643230616874405, 34.39542172649191, 30.37204922306295,
+	26.551371679261642, 23.010907482886218, 19.62121443928753, 16.456550894011254, 13.302418302787354, 10.327586488487714, 7.317871662221335, 4.406921010605381,
+	1.3955942916957362, 0.0, 0.0, 17.97615031110421, 21.767784278130975, 25.520908139158085, 27.305927812280228, 29.746731595647983,
+	31.389906837247484, 33.682624184684585, 35.84389707567592, 37.33389073914054, 39.38685098088825, 41.3856617682259, 43.33424273764253, 45.23621789214043,
+	47.045316438085614, 48.86663361004084, 50.650172327171326, 52.3504446496077, 54.06836440178944, 56.21380217650047, 57.35660284995609, 59.395820204876784,
+	60.9950447945669, 62.95863993133617, 64.49489302441843, 66.40630546049029, 68.32028639866736, 69.74892986489834, 71.56917690910026, 72.9558782019287,
+	74.76612976692061, 76.11640201596511, 73.7835863362929, 66.50617932375006, 60.03639940583541, 54.08868684555398, 48.73131471873116, 43.67198220217184,
+	39.030735466499785, 34.77311777940564, 30.73486885666233, 26.8929050706114, 23.22711830508204, 19.830913249118716, 16.562617482338126, 13.40184925603854,
+	10.436185117422534, 7.425040013038656, 4.5137221102923535, 1.3955942916957362, 0.0, 0.0, 16.468674039718845, 21.767784278130975,
+	24.19008605189058, 26.695589106216033, 28.49587640783266, 30.14631475297169, 32.49057021500097, 34.044399244680655, 36.17739268175378, 37.73896658908344,
+	39.76948085136597, 41.74274500381464, 43.60864770437709, 45.02966855400417, 46.81473649380703, 48.644927638032115, 50.362569861644616, 52.12620182159459,
+	53.7839817045567, 55.48816886918662, 57.519899767375215, 58.671996522838015, 60.27840159357615, 62.24151118428205, 63.748656404314914, 65.23568476971316,
+	66.73943442936455, 68.21103724787066, 70.42102195389921, 71.82592032688, 73.2151777716166, 74.89787618146644, 67.73165907881847, 61.03862193931107,
+	54.9861073354668, 49.33034596137343, 44.260002932378455, 39.607320692712044, 35.19629054864661, 31.006547431799426, 27.135144655005384, 23.476756629094304,
+	20.101093284077386, 16.680932892358154, 13.516964093506637, 10.436185117422534, 7.535940207218811, 4.5137221102923535, 1.3955942916957362, 0.0,
+	0.0, 16.468674039718845, 20.35993540576828, 22.899737464591205, 25.43112697351866, 27.272350374514545, 28.99775266153937, 31.320089413936774,
+	32.888092623004496, 35.03993283462372, 36.04149337260387, 38.10392812377796, 40.11937360295921, 41.56230025055733, 43.459656078681405, 44.81058088096842,
+	47.11872003828467, 48.49203117574188, 49.80424748190893, 51.89762801697253, 53.137716098055, 54.86441586926804, 56.87252502744228, 58.05023943293855,
+	59.612139120252735, 61.24064070469678, 62.75929499077957, 64.25896757347984, 66.10743029829766, 67.56675060189556, 69.00940446771477, 70.43606552324263,
+	72.19903286161961, 69.10836340380033, 62.1949875418144, 55.93383234573927, 50.29929478513547, 45.06649399727175, 40.246913003264524, 35.66210985885502,
+	31.45628843182194, 27.59970050185787, 23.758238348184495, 20.36785470534957, 16.95273527068318, 13.791780057945683, 10.564343723348049, 7.535940207218811,
+	4.5137221102923535, 1.3955942916957362, 0.0, 0.0, 15.247974908747706, 19.512734149038373, 22.186637179939723, 24.73671887762499,
+	26.084706905488318, 27.91166254599206, 29.538591711477924, 31.786420105444126, 33.4512019003937, 35.10932137244564, 37.06746207642346, 38.635968164033436,
+	40.06773808831268, 41.48366087931654, 43.905674056108964, 45.30802835789619, 46.647147530479145, 48.3646605181762, 50.173134946880104, 51.45301481672032,
+	52.68461606203907, 54.71933636200803, 56.362270578048694, 57.53230364968911, 59.09462706618706, 60.70014894032422, 62.26696143291206, 63.77184955298206,
+	65.25943076661643, 66.71639742326373, 68.10313101234519, 69.52641301108919, 70.63985533573484, 63.4505034527824, 57.07597750905832, 51.15888969056378,
+	45.76182044996032, 40.94338322276191, 36.2804746299922, 31.953664322407516, 27.926955352439016, 24.236616709993843, 20.524978073250626, 17.105418492665255,
+	13.950217080819469, 10.713539356700075, 7.689152347889279, 4.6739876111019045, 1.563496612053199, 0.0, 0.0, 15.247974908747706,
+	18.967893121494313, 21.660602345461896, 23.58343335419845, 25.418258535169546, 27.26574632387618, 28.54510405394982, 30.826426216466256, 32.390350424116114,
+	34.0824545230399, 35.58911129009775, 37.205548659002204, 39.08781255259397, 40.66183410671144, 42.07167972323306, 43.84827365628252, 45.26777191876984,
+	46.66604909166017, 48.005528018147295, 50.08387093288948, 51.379030707026594, 53.09986973471949, 54.281256381100455, 55.96702331794342, 57.118574851251985,
+	58.681279895729595, 60.2324951944112, 61.77944212073348, 63.27885274535757, 64.09599747408981, 65.51329159968161, 67.38144110635054, 68.83145352721397,
+	64.9374663690594, 58.22573801913336, 52.23552003392085, 46.68390927311796, 41.69048767503897, 36.898560165577386, 32.49523319536349, 28.461619645778974,
+	24.590585686841184, 20.864735372913426, 17.44231918982521, 14.08628521198956, 10.880070720729696, 7.689152347889279, 4.6739876111019045, 1.563496612053199,
+	0.0, 0.0, 15.247974908747706, 18.309902289665704, 21.033925248296036, 22.5791338886745, 24.445147396843108, 26.1683040503362,
+	27.999074623692653, 29.788034540535218, 31.36447316771996, 33.08652522277152, 34.608204128029016, 36.271956414068, 37.74677969400438, 39.20094872855099,
+	40.7560257149825, 42.209867154310516, 43.96276394475331, 45.33955776654968, 46.7018957858646, 48.53294851054994, 49.846572183821166, 51.05356514816713,
+	52.802671021632264, 53.975565710089064, 55.68031168343734, 56.808246423240455, 58.36879140412238, 59.91249909566765, 60.78470745753481, 62.2606826903509,
+	63.72573078704108, 65.2127107711984, 66.39602520328084, 66.63089262760167, 59.661450356113775, 53.52763287425008, 47.852429542319484, 42.481923498605575,
+	37.68794790495034, 33.28013341298793, 29.045058781203497, 24.970694179353863, 21.236649366247654, 17.818571137289712, 14.30596319017884, 11.063676959024065,
+	7.868652424044459, 4.6739876111019045, 1.563496612053199, 0.0, 0.0, 14.18862705569287, 18.309902289665704, 20.052491267496436,
+	22.5791338886745, 23.861256229752865, 25.292058371836816, 26.981709121918712, 29.190983944530835, 30.513626443941906, 31.755376971980667, 33.7667593238643,
+	34.90819998782381, 36.83929890007123, 38.404707544172155, 39.416035502660726, 40.92978965005183, 42.43999079943897, 43.844633612940825, 45.552816022746676,
+	46.92287470468313, 48.5931664722167, 49.62369855373267, 50.95564507379419, 52.5725019697595, 53.8399145825162, 55.46044305917449, 56.59948499377555,
+	58.1550617898792, 59.41064911591949, 60.54031008498383, 62.03762033523503, 63.264372722356995, 64.75163318002465, 66.10580684745398, 61.25892259451907,
+	54.82682866207059, 48.863813015622725, 43.523932555641565, 38.528929093037355, 33.91151930700371, 29.687602460974134, 25.58637071079696, 21.638524021177858,
+	18.05445804855543, 14.70935171856177, 11.263253265651258, 8.072071499763057, 4.880179111240669, 1.563496612053199, 0.0, 0.0,
+	14.18862705569287, 17.289997930999625, 20.052491267496436, 21.93563998820688, 23.4538259307634, 25.292058371836816, 26.59769820069804, 28.365806733652388,
+	29.64144209658134, 31.209455088862867, 32.94143263918141, 34.12600038338091, 36.00245138496618, 37.117749723975415, 38.70071992751532, 40.208007919723194,
+	41.64065144633406, 42.65172221557019, 44.066660755889195, 45.43181986673841, 47.22304147688292, 48.58478353252972, 49.81452717137518, 51.121347338650324,
+	52.72594049359416, 53.76667463295667, 55.34057043720086, 56.48958736753963, 57.77357703363401, 58.937982031340525, 60.430377596544304, 61.68423397703519,
+	63.18036966780932, 64.03283492004478, 63.070177057782374, 56.277721645439215, 50.312874893135906, 44.61302997187192, 39.414682620164584, 34.80401558421143,
+	30.341724942970064, 26.252009946241223, 22.243222241674147, 18.44982801458749, 14.927387054107903, 11.477723524981068, 8.297121116685926, 4.880179111240669,
+	1.563496612053199, 0.0, 0.0, 13.319459326792924, 17.289997930999625, 19.416045863679184, 21.006417472146353, 22.8535557505337,
+	24.38079770683575, 26.13513799663467, 27.50414134219106, 29.09849435820734, 30.428354275037176, 31.719245601072384, 33.61578915942563, 34.81583968539631,
+	36.432320809760476, 37.49443094131578, 39.0682025541361, 40.57941750232926, 42.01070976676831, 43.42750716282874, 44.830340431323926, 46.19385505918562,
+	47.47928675230771, 48.80474789427769, 49.90028691046745, 51.10690420686411, 52.749083169937485, 53.757162112874106, 54.92592600339961, 56.47512984869044,
+	57.76896029671094, 58.91221109132732, 60.18844692829272, 61.317676946087396, 62.57800133011571, 63.94834656960107, 58.12942575526093, 51.770668872723654,
+	45.964923055054435, 40.574659182693715, 35.747427747889446, 31.047005453575697, 26.72104011346403, 22.760449682867478, 18.910848877168903, 15.39323901666352,
+	11.706050050912715, 8.297121116685926, 5.12499616153314, 1.563496612053199, 0.0, 0.0, 13.319459326792924, 16.650004083332533,
+	19.039997462650444, 21.006417472146353, 22.132472481272632, 23.84116766890684, 25.353959418262118, 26.98242934786307, 28.43036402226127, 29.72569480448916,
+	31.278462069444053, 32.954396344593036, 34.14111771612638, 35.28589657477986, 36.92319929417939, 38.40152573028364, 39.50304908189199, 40.98290147945365,
+	42.41515183653224, 43.80186524695381, 45.14532605640545, 46.255118639171044, 47.63524587321042, 48.94013961616682, 50.20733418370777, 51.297274577561986,
+	52.88750283388176, 54.070595676141124, 55.05284142369878, 56.55210976003529, 57.550545391452964, 59.0013609922516, 59.93407279703826, 61.208142508477216,
+	62.630481720265166, 59.96665774437186, 53.405566774219736, 47.346222087193084, 41.781422155587244, 36.73443919880001, 32.03798464291218, 27.45894848611311,
+	23.50408537992493, 19.40069873785455, 15.6399565147122, 12.200353670271298, 8.541636184318802, 5.12499616153314, 1.563496612053199, 0.0,
+	0.0, 13.319459326792924, 16.650004083332533, 18.59779786450599, 20.25276356611406, 22.132472481272632, 23.20750972400368, 24.84009886103106,
+	26.36611099838627, 27.675500864293962, 29.245277416998114, 30.57022831281389, 31.851713048369245, 33.5020147377566, 34.748370884690225, 36.234478394921794,
+	37.4602849585486, 38.918525531874636, 40.001272452258064, 41.436939676409345, 42.83073738868796, 44.224781533528265, 45.395566664474224, 46.777622420320434,
+	48.0214666714472, 49.15323852938022, 50.41343000696031, 51.49924137632084, 52.692160722731444, 54.228504655309926, 55.26390182627628, 56.30923361294887,
+	57.724251472866044, 58.720157377095, 60.12344627636665, 61.40290067388932, 62.15218176290363, 55.148955498696196, 48.96761652357232, 43.2739471436926,
+	37.758049994241674, 33.079161072306, 28.234081564858162, 24.025380571617948, 19.916732672005814, 16.158394429334287, 12.464500384637613, 8.803601785990265,
+	5.401827417833477, 1.563496612053199, 0.0, 0.0, 12.801284395062618, 15.946013857238889, 18.158100738625592, 19.740638633171965,
+	21.348997609034598, 22.725708021073498, 24.2895047677664, 25.890550806531525, 27.30259096823763, 28.71131890417038, 30.021828349715257, 31.31403331049741,
+	32.59762648838822, 34.326592749819476, 35.35493028375358, 36.82530548947229, 38.06451789126517, 39.50971316622413, 40.56862125044111, 41.99154311594668,
+	43.19688154961674, 44.597317516385196, 45.922656122775024, 47.014656415888744, 48.38515293871916, 49.598056592085634, 50.75671477252781, 51.95572487277239,
+	53.023580747536215, 54.28880652151857, 55.54951057663247, 56.593434029939935, 57.97320202399359, 58.971615477188585, 60.2132851561363, 61.36101230516958,
+	57.3679442735685, 50.79486556196202, 44.729744013427286, 39.0788725232588, 34.16272489605679, 29.318218499150355, 24.841449607229812, 20.734549622018367,
+	16.706999901069356, 12.738846732809167, 9.081166920178495, 5.401827417833477, 1.8824935920206254, 0.0, 0.0, 12.504969463754342,
+	15.946013857238889, 17.884477256018855, 19.740638633171965, 20.99435444965417, 22.725708021073498, 23.849394009910956, 25.343837749261525, 26.740669515987378,
+	28.294411265581225, 29.60830297837837, 30.91383538052038, 32.207215102866165, 33.492918875843415, 35.004468122674915, 36.23502751479325, 37.49013037389765,
+	38.72983230503858, 40.158066469842225, 41.241784917744106, 42.46581007898793, 43.8546354419365, 45.14811173095649, 46.30205326767452, 47.35150869860597,
+	48.50387686645636, 50.03080722602047, 51.09503586833843, 52.316348200313136, 53.42503096954876, 54.47332770785519, 55.652399714894166, 56.947238344605545,
+	57.963491425055125, 59.2932122759336, 60.279656805753994, 59.61340442445573, 52.76321140453886, 46.326176174238626, 40.708148053889865, 35.281400600840755,
+	30.451810248977132, 25.693870701059982, 21.305689322021582, 17.282293526065036, 13.341238378501663, 9.372648317300934, 5.704947653124111, 1.8824935920206254,
+	0.0, 0.0, 12.504969463754342, 15.49556780479612, 17.51031557899925, 19.245869731395555, 20.99435444965417, 22.20769737925815,
+	23.628898879656983, 24.99518725242096, 26.390943367572493, 27.811915877944656, 29.182920416340732, 30.5063096208327, 31.789891638367383, 33.033935987545796,
+	34.24366557916487, 35.770569473817176, 36.96109747875279, 38.22027697477356, 39.43282949254996, 40.81789543377021, 41.8073608637359, 43.16054235293809,
+	44.45467154625302, 45.65058012509073, 46.71944902112652, 47.86156532109359, 49.13272035922215, 50.22202137868266, 51.36871245386245, 52.56443543512919,
+	53.87946679555176, 54.91473285993412, 56.09019623400207, 57.117974832178874, 58.39018720321597, 59.3989967645564, 60.393832152739876, 54.958166487102254,
+	48.348230576980434, 42.366677244555895, 36.71883351040089, 31.513954837025864, 26.577918725665597, 22.196278730497973, 17.881154062303487, 13.615552896185823,
+	9.67604483349976, 5.704947653124111, 1.8824935920206254, 0.0, 0.0, 12.1427472103621, 15.49556780479612, 17.51031557899925,
+	19.245869731395555, 20.460860407375336, 21.864519714485272, 23.39019507851522, 24.551229189546582, 25.980241810597494, 27.502946947807082, 28.837371083100845,
+	30.160666880752522, 31.47331549973222, 32.61309811782465, 33.90817928596079, 35.10625039664502, 36.57487356892833, 37.748546118339185, 38.81015807471716,
+	40.181098670961624, 41.52656855258023, 42.55253662748712, 43.84271645709667, 45.06287581303618, 46.148935588036096, 47.289123772914074, 48.43494756071958,
+	49.65909207075685, 50.81271425384292, 51.89260825009052, 53.079547968649, 54.15848818175855, 55.238421951471274, 56.46917767091576, 57.509755302227745,
+	58.668299749447705, 59.70049184771929, 57.553637388897066, 50.54745318040267, 44.03535857144597, 38.188282238135706, 32.8347742203523, 27.79853109707909,
+	23.12219351548027, 18.50079625866894, 14.238118243567412, 9.991441318517804, 6.02954296915667, 1.8824935920206254, 0.0, 0.0,
+	12.1427472103621, 15.042683257585287, 17.246939069522366, 18.86260036149244, 20.191771976837874, 21.689034663415445, 23.08055347541433, 24.551229189546582,
+	25.698139074869836, 27.111945931840427, 28.485972149009328, 29.821177674155745, 31.11958303503907, 32.38376749605945, 33.61825439396992, 34.77120770367483,
+	35.99939171246519, 37.41535465824117, 38.58887827249296, 39.640860933921054, 40.971700831458584, 42.03060972722438, 43.31583129389445, 44.5415203572325,
+	45.642343313108434, 46.786529107610264, 47.9453964766761, 49.15963849795843, 50.31733763151152, 51.41515619389226, 52.48648073626406, 53.68255896841438,
+	54.77078495466176, 55.84353848463844, 56.90159791955426, 57.945732871323955, 58.97669764849598, 60.147056484493184, 53.04452380676662, 46.244777120393294,
+	39.97793768033678, 34.38193087033115, 29.058844037590237, 24.078133385861893, 19.13874343569301, 14.88585782017643, 10.649643422121521, 6.371640937207506,
+	1.8824935920206254, 0.0, 0.0, 12.1427472103621, 15.042683257585287, 17.08191198967935, 18.547925509222175, 20.191771976837874,
+	21.491395802961097, 22.757913953342772, 24.167921819131173, 25.53266522509337, 26.88510104042789, 28.22583577318127, 29.55541263040474, 30.7476972699673,
+	32.05594455042344, 33.35303571524168, 34.56644472453631, 35.68727716361966, 36.92164441284331, 38.107811043742714, 39.36271229830156, 40.507619997280216,
+	41.739100124146574, 42.86370727909515, 44.08899608727273, 45.20195380209388, 46.35350691702782, 47.515426261394644, 48.61282893404828, 49.705844466147724,
+	50.829904628122655, 51.956579336276334, 53.052624901867425, 54.12653732987833, 55.28634102254291, 56.350208382475415, 57.409946234192006, 58.465571629748,
+	59.61874279189354, 55.77711121264651, 48.662552692415595, 42.07927271302091, 35.95881654279792, 30.351003579369582, 25.05947206217679, 20.125247594569434,
+	15.5549824418258, 10.990887045293508, 6.655110341491942, 1.8824935920206254, 0.0, 0.0, 11.846662128655211, 15.042683257585287,
+	16.866570659670153, 18.547925509222175, 19.86435567491255, 21.269870377695838, 22.757913953342772, 23.999482181788803, 25.410182963880683, 26.610603296707893,
+	27.971142517355585, 29.302370589688326, 30.418749878518586, 31.72207357632215, 32.92257567453841, 34.15004267350243, 35.38126907346137, 36.647681456547694,
+	37.8715015249522, 39.0583004385412, 40.133478827932336, 41.35284201481088, 42.49435772504106, 43.70764592734265, 44.82991903369626, 45.9895183757791,
+	47.14683027004782, 48.17303836252281, 49.35210743908832, 50.4837420367191, 51.61082749050998, 52.57149010764055, 53.67162939945885, 54.75907357845428,
+	55.834198508408846, 56.897422902316876, 57.94919472624491, 59.115721539366994, 59.08326059266701, 51.442997318265796, 44.46573767045143, 37.87510263960947,
+	32.00055292815262, 26.400519474929073, 21.18353904635784, 16.242275202022558, 11.339052231008791, 6.836712037242458, 2.2776293052302607, 0.0,
+	0.0, 11.846662128655211, 14.817354129559511, 16.866570659670153, 18.340322689614553, 19.723142826889692, 21.16933106671935, 22.556247775313018,
+	23.837998152085508, 25.22276851785622, 26.453992989701472, 27.78502165547282, 29.106494985106593, 30.240924142274512, 31.511514973353755, 32.76014070394768,
+	33.95187370057651, 35.194530034405666, 36.393741380138664, 37.55330476055371, 38.734622849672, 39.967506860827974, 41.114449643490076, 42.2050293797873,
+	43.399629972198824, 44.52822374340251, 45.69375170432528, 46.744569238181306, 47.8884274996062, 49.056587720020595, 50.09418182126769, 51.19373225335615,
+	52.30529168472063, 53.40517945312575, 54.49353909755313, 55.57059510862541, 56.63663317610101, 57.691984253467226, 58.73701172452611, 59.77210109126351,
+	54.56764314796044, 47.086655374791434, 40.12259315359131, 33.677360054761195, 27.771356478113994, 22.182627521437947, 16.94500698632565, 12.053209247092195,
+	7.095991055367092, 2.2776293052302607, 0.0, 0.0, 11.846662128655211, 14.817354129559511, 16.740518060228307, 18.340322689614553,
+	19.723142826889692, 21.070609624250324, 22.388672988416385, 23.68301131176127, 25.04482038990767, 26.303390583707177, 27.634982866287725, 28.831659714547655,
+	30.115252367529532, 31.39963173792496, 32.617829127019995, 33.85477786839783, 35.01746938326883, 36.25575074337001, 37.43756340982863, 38.59140395554072,
+	39.72126619801121, 40.87343515145707, 42.077686577285625, 43.166881731607916, 44.29864764991864, 45.46511873163169, 46.53574544285672, 47.66565103718674,
+	48.77277559642102, 49.874178980446636, 50.98229076178578, 52.01629487167437, 53.101136644671925, 54.183287547887595, 55.25984690253829, 56.326889061466936,
+	57.389469262351135, 58.40645472163537, 59.461306271167565, 58.19920655716778, 50.12769554508314, 42.67690118508653, 35.71112508083188, 29.516284786665427,
+	23.60116293353317, 18.023080275343187, 12.787039693534545, 7.473485949133334, 2.2776293052302607, 0.0, 0.0, 11.758681858391462,
+	14.679030387340916, 16.685768901386457, 18.221589367443084, 19.62371225845371, 20.967220050264828, 22.29901261653452, 23.68301131176127, 24.958701582060574,
+	26.220662513686815, 27.531982211775432, 28.780463740557146, 30.02514044288379, 31.283706590275404, 32.473355579722686, 33.67720635344683, 34.88504335390162,
+	36.07720040983017, 37.25904490689652, 38.426250423376366, 39.59378387608821, 40.78822412671644, 41.90173685044952, 43.04064884069639, 44.143894961681326,
+	45.30225686932802, 46.389220272495024, 47.50589096242219, 48.58310655701831, 49.71276583054567, 50.77628713768513, 51.86446152510736, 52.95169194284197,
+	53.99532650710254, 55.03947325373038, 56.10524318906987, 57.1662003616931, 58.21798964298497, 59.21274350404784, 60.25794645677492, 53.613120466507354,
+	45.49219017430315, 38.09407777795611, 31.64139545537501, 25.04676372728601, 19.124338096465763, 13.536549670286055, 8.250423200773936, 2.70940924456199,
+	0.0, 0.0, 11.735977281609152, 14.679030387340916, 16.650644698410005, 18.221589367443084, 19.589353191127202, 20.967220050264828,
+	22.29901261653452, 23.610791659209486, 24.910434490242167, 26.181959338610145, 27.467354265260447, 28.71427763963742, 29.97199792015829, 31.2008748037316,
+	32.41500626589843, 33.6314037402282, 34.83403139172534, 36.02797787110706, 37.20563295528664, 38.37685326670947, 39.5342365079524, 40.67734127525173,
+	41.822253197001366, 42.9460247096078, 44.065632450861955, 45.20109222922802, 46.30170492759123, 47.39700081205576, 48.49823430340777, 49.59330925384087,
+	50.68267459666067, 51.75160807345637, 52.81545227243584, 53.886128149072825, 54.93404158976519, 55.99215871136739, 57.04404941629619, 58.089557554856825,
+	59.10304493525077, 60.135101989243225, 57.88017942974034, 49.07505936349698, 41.1405699860896, 33.78519623827532, 26.881246941311908, 20.620132178398933,
+	14.29858333644906, 8.647332410123138, 2.70940924456199, 0.0, 0.0, 11.735977281609152, 14.679030387340916, 16.650644698410005,
+	18.193358375784367, 19.5728421928203, 20.931358287850575, 22.269750517102054, 23.590002276815074, 24.89496027161932, 26.181959338610145, 27.450634804230706,
+	28.70886543313904, 29.952613399467992, 31.187896329717795, 32.403920822014776, 33.61274081721679, 34.81092177574067, 35.99875322784426, 37.174266435022716,
+	38.34231085606607, 39.500769865968486, 40.65026451969372, 41.792078400636186, 42.92573510141679, 44.05204468619532, 45.16678618136388, 46.27728259472131,
+	47.38127063839485, 48.47521995709099, 49.563347159505376, 50.6481165153463, 51.72152125963331, 52.792007613145664, 53.85297154342276, 54.91289290205095,
+	55.961535492376164, 57.00572930276071, 58.04537494628893, 59.08071115304222, 60.10818531084836, 61.1305115217324, 53.13845101068997, 44.38647748595759,
+	36.29165366561499, 29.108740168155347, 22.072155977505243, 15.459771056943595, 9.048562279024386, 2.70940924456199, 0.0, 0.0,
+	11.735977281609152, 14.685129534236864, 16.683501217486196, 18.221942430128735, 19.608281821274556, 20.960067993067113, 22.297909593945874, 23.621982851944285,
+	24.933539419812135, 26.187292738993882, 27.481725676709097, 28.744045880625357, 29.96655686368015, 31.2258442406334, 32.42185350231366, 33.6425381760583,
+	34.84031547970766, 36.03285747108906, 37.22718900445429, 38.390246215585364, 39.532095272103994, 40.69361851080754, 41.83559417400615, 42.97121931024549,
+	44.10072649465149, 45.224417048806366, 46.30524522064917, 47.41362697167916, 48.51456346982934, 49.60812743436338, 50.69441931891495, 51.77447388361433,
+	52.82987683994631, 53.90558795702712, 54.948867979909856, 56.01276612395383, 57.05856756188919, 58.11143825566866, 59.13545312586943, 60.16615815334588,
+	61.17389905652252, 58.271187196061085, 48.5696378400143, 39.49396593431785, 31.35072358297307, 24.059334387949722, 16.637149008580256, 9.861076622367957,
+	3.158933925056267, 0.0, 0.0, 11.798305532837425, 14.685129534236864, 16.683501217486196, 18.265408842782705, 19.608281821274556,
+	21.018751943129445, 22.35716945196041, 23.673392055833872, 25.016122323345037, 26.248075859988557, 27.52613227181016, 28.810775408080886, 30.08986751468173,
+	31.277342411095923, 32.50726297091327, 33.71551819003856, 34.9208228704948, 36.12708540018394, 37.2874775271116, 38.44190949183772, 39.65632474753705,
+	40.80676502354917, 41.94549885752147, 43.0796070366485, 44.21762224323773, 45.31335450384418, 46.433477060836815, 47.50677689775921, 48.61655691921105,
+	49.711155314671686, 50.828301597669416, 51.87662855038549, 52.95783490510305, 54.04803034426329, 55.07494627399996, 56.16215401025804, 57.177092151566164,
+	58.251029686454, 59.25628239218371, 60.279998936960055, 61.3377265053183, 62.348646127031024, 53.443544031507436, 43.67137591206317, 34.34206202610138,
+	25.994572072342294, 18.224236174377722, 10.68334465247348, 3.6169761395997044, 0.0, 0.0, 11.798305532837425, 14.815753329809027,
+	16.817727438080638, 18.37081403978583, 19.71605798361025, 21.076973436721904, 22.412065586253398, 23.72425187687224, 25.016122323345037, 26.32104552339016,
+	27.618628576771666, 28.911117423330314, 30.19657661142554, 31.41041593382442, 32.63692831516064, 33.84605099163398, 35.059448009571, 36.22573673299318,
+	37.435730392969745, 38.607419953492965, 39.749308359739494, 40.91285705876938, 42.12355148300353, 43.22493896952581, 44.36495841754601, 45.49469612688256,
+	46.61447836500015, 47.724641263832936, 48.825523897130296, 49.86834827243579, 51.000789783852134, 52.07765347980794, 53.177938085140084, 54.19058839689678,
+	55.27780632833006, 56.374505856637164, 57.37947311977763, 58.45182884882318, 59.47399522595633, 60.54241271470324, 61.56057377752484, 62.610644278716265,
+	59.655399419668775, 48.31607958860799, 38.05195956376405, 28.714451903158643, 20.225194096989547, 11.928340717461971, 3.6169761395997044, 0.0,
+	0.0, 11.798305532837425, 14.815753329809027, 16.817727438080638, 18.37081403978583, 19.876206481299775, 21.19480812949314, 22.513847884438878,
+	23.829551305596663, 25.14021570399219, 26.44254220964641, 27.751624805581116, 29.04032690584263, 30.357359713939452, 31.619377257739814, 32.814001764410186,
+	34.03713692069101, 35.20745397194991, 36.43731112081364, 37.58019092848679, 38.8421800829346, 39.997369596006024, 41.099653969610046, 42.33360624185283,
+	43.472505694620224, 44.593053095483874, 45.71186792250659, 46.828662859203284, 47.94326147270145, 49.05557232501988, 50.1522246068331, 51.28632095534995,
+	52.37873555676316, 53.48204417185459, 54.47214647322676, 55.596791284446525, 56.66684658882536, 57.65435805891106, 58.76325654190083, 59.8351154712357,
+	60.81209993548707, 61.90243736579297, 62.882524776601166, 63.96261066999894, 54.56824963191789, 42.76565614824488, 32.206708051859955, 22.63832242823852,
+	13.181289904126725, 4.0353810605806135, 0.0, 0.0, 12.007923232740225, 15.019015578794512, 17.024327936309675, 18.565201563429593,
+	19.876206481299775, 21.39187853131876, 22.69426894967188, 23.99908206794524, 25.361373264589414, 26.605029998760415, 27.903944983200553, 29.259115970636007,
+	30.49304165661453, 31.74194009322463, 33.07282094211501, 34.23539571752231, 35.47109157456596, 36.75301132436361, 37.876247745479006, 39.07813876144095,
+	40.25575319434171, 41.37113304261756, 42.6385135242209, 43.75029303058998, 44.86261436092136, 45.97480823653717, 47.08637630144742, 48.339523516817394,
+	49.43899446975795, 50.51545344865007, 51.658012945165694, 52.64144355014697, 53.72869228975277, 54.83036045528723, 55.94185032772325, 57.02912954224335,
+	58.089019289561634, 59.09386446519769, 60.18644460338595, 61.278902617007226, 62.24395365076936, 63.3328047903137, 64.29741811753074, 62.733961682874835,
+	48.94853716012831, 36.813520751844294, 25.45597492499838, 14.857121023342373, 4.541941504336611, 0.0, 0.0, 12.007923232740225,
+	15.019015578794512, 17.024327936309675, 18.565201563429593, 20.068729565573353, 21.39187853131876, 22.945648006004447, 24.213301532465657, 25.50232891670364,
+	26.797722729292538, 28.176543753912956, 29.386970550145907, 30.67982800312717, 31.971427377430153, 33.315098447932336, 34.55158157417682, 35.84064354157587,
+	36.99310834768913, 38.20962488744581, 39.31665105101879, 40.5990219892982, 41.69865673385962, 42.97806906928451, 44.077621621617354, 45.19676506573908,
+	46.45337315475847, 47.553467330447454, 48.654517164315045, 49.75602848095246, 50.85761783201935, 52.0145277357479, 53.05992788884309, 54.16026372743308,
+	55.259881852059934, 56.35870184688803, 57.45667214510032, 58.56957295770538, 59.64996441504174, 60.59631764224167, 61.690642117003655, 62.80940184769977,
+	63.8764267074889, 64.84003918562318, 65.90789071096113, 57.29856558127269, 42.420337935686256, 29.463438531532727, 16.952479381866706, 5.467091538858836,
+	0.0, 0.0, 12.237944091420811, 15.310022111280821, 17.23578446599176, 18.832165198451825, 20.36892452613538, 21.654115108045385,
+	23.180360874281376, 24.453371929591334, 25.73480621085871, 27.110851766054854, 28.552979791173037, 29.774223814455326, 31.122875928683012, 32.33296440136304,
+	33.6988265627571, 34.801358297834604, 36.05119250457117, 37.34128890867045, 38.63154710200647, 39.707735288699624, 40.995949908995826, 42.15089146249286,
+	43.29634170889146, 44.42745718401497, 45.741545644095645, 46.84169195376128, 47.93000336412072, 49.19685783482587, 50.28710123258153, 51.379022420453886,
+	52.52246389348416, 53.56608879794678, 54.660539845965424, 55.744403428321654, 56.85002637699407, 57.94470837337131, 59.04367628524199, 60.1333320506426,
+	61.22710605463706, 62.32044218738357, 63.41329950797212, 64.34074392560159, 65.43279538266896, 66.52421280700669, 67.56594075812306, 50.80958860162842,
+	34.60614941581878, 20.29313834071027, 6.387166975606853, 0.0, 0.0, 12.237944091420811, 15.310022111280821, 17.390550826470506,
+	19.12836625351942, 20.36892452613538, 21.92131226499936, 23.477932738243375, 24.72377346783191, 26.062448499286376, 27.550070335020756, 28.780354240318243,
+	30.096002638455733, 31.376052590342155, 32.65906221910881, 33.998619662071334, 35.2310593941291, 36.519183315664584, 37.80839301156075, 38.95589769144178,
+	40.14387756305786, 41.4299808594237, 42.504446720537835, 43.79642314021065, 44.86647397387961, 46.15798306479613, 47.232698644485296, 48.533749358285476,
+	49.60124283024487, 50.682392099630846, 51.97104423988013, 53.00156043574292, 54.137581469454645, 55.223614773267464, 56.391330397980745, 57.5944297972565,
+	58.620431779405266, 59.768839671255364, 60.802072294844045, 61.94565328848109, 63.034544584026634, 63.938874639094145, 65.0351250767761, 66.11253454328576,
+	67.20884167551948, 68.29834389410605, 63.612059155769586, 43.01976966572048, 24.433743171251223, 7.300628409899036, 0.0, 0.0,
+	12.440085868668337, 15.689463712854502, 17.63515240638881, 19.12836625351942, 20.68450243531222, 22.243812030014578, 23.477932738243375, 25.038888674035622,
+	26.288458681992587, 27.811099570237673, 29.109179058200752, 30.375679731216245, 31.64974943485092, 32.925440743556045, 34.48310941540331, 35.7618126598038,
+	36.77477341218669, 38.061940972743564, 39.35057463572169, 40.640474094445466, 41.93149049433391, 42.97397699491842, 44.26729178518882, 45.56130374415638,
+	46.61501888590536, 47.9098838881914, 48.970572612667254, 50.26568105231277, 51.331301500478354, 52.62634079824379, 53.64321809978106, 54.76808232157672,
+	56.0521354811888, 57.136332884468025, 58.213093301463665, 59.33548083955377, 60.372137980684435, 61.501199348865164, 62.536556637161034, 63.62065565241073,
+	64.70450816975323, 65.78936647637423, 66.87462415310529, 68.03206141973742, 69.04587905633629, 70.13168287277709, 56.36639416049691, 31.354109277803186,
+	9.554859967285614, 0.0, 0.0, 12.82514008336943, 15.689463712854502, 18.019435785160624, 19.481885226166163, 21.00089011948505,
+	22.624857135535166, 23.838387476608606, 25.372515511906776, 26.604009819277675, 28.172949411412784, 29.418220656789863, 30.98956914256502, 32.24387183670565,
+	33.505983873507326, 34.85043247490888, 36.04743771787094, 37.32458261396012, 38.60496729539829, 39.8880361189481, 41.17803225386078, 42.46059051666603,
+	43.74946260344693, 44.82390979249398, 46.06698548610845, 47.36078229323596, 48.399888643739075, 49.69569326086333, 51.000154938650816, 52.04042569974191,
+	53.337705404389084, 54.35267825496049, 55.451367219145084, 56.74879808342792, 57.81168231019959, 58.87791510230055, 60.17455523873816, 61.24292468298968,
+	62.31365513471064, 63.38511353183881, 64.46089553848955, 65.75442926122656, 66.75419087169857, 67.90558016986617, 68.98277631821074, 70.06081806131243,
+	71.15055695436257, 72.17366006718234, 44.93352097001363, 13.086264886259807, 0.0, 0.0, 12.82514008336943, 16.114767117413436,
+	18.019435785160624, 19.89781992715172, 21.424084132687323, 22.98030019978539, 24.16211160982989, 25.7290861467542, 27.298936767064387, 28.513794128439848,
+	30.088371535024386, 31.320413617711043, 32.5888111672323, 34.07262583638055, 35.39435276870044, 36.65421465457898, 37.953522921713734, 39.1560889687583,
+	40.465336220943286, 41.74344392090632, 43.024422290618375, 44.30783676009271, 45.53912153659051, 46.885293514854126, 47.93205884323698, 49.18548861712201,
+	50.47840987172574, 51.68068682518124, 52.80215186354559, 54.09814534725339, 55.12654220756176, 56.43407171888055, 57.47930263919253, 58.77730940475797,
+	59.82771348250388, 60.88220124435671, 62.116745458132606, 63.23814600857871, 64.3255709776326, 65.54403311162204, 66.6590889684524, 67.72411582475723,
+	68.8465427899786, 69.85960433085441, 70.92959776729272, 72.2230233569288, 73.29332225685584, 74.36462309313077, 21.21104545264793, 0.0,
+	0.0, 12.82514008336943, 16.114767117413436, 18.389991928392565, 20.295975020733223, 21.836710973957196, 23.385191364609955, 24.541035572136373,
+	26.135002556649397, 27.673130926372735, 28.87128586719828, 30.445731570871814, 31.80739294201862, 33.24331741635651, 34.477160702751696, 36.05769363499338,
+	37.270053571722414, 38.554145832256864, 39.81283266203312, 41.07701420227842, 42.34582947986128, 43.618568674546125, 44.89464242028292, 46.17355729344756,
+	47.45489633861115, 48.829642482816766, 50.02347216481973, 51.31013407616115, 52.55500021028939, 53.64274021029385, 54.90094420525396, 56.09672598408146,
+	57.21730904710951, 58.47469944611331, 59.54389126123292, 60.78255790383434, 61.87816759742748, 63.17463562810079, 64.21815482091282, 65.51470327308995,
+	66.56226805350462, 67.61309999667839, 68.90921751199184, 69.96272072518637, 71.01866800027081, 72.12400463447072, 73.37195287229657, 74.37194555847687,
+	75.4760997374029, 40.40817373826219, 0.0, 0.0, 13.420069336393366, 16.581991509118883, 18.898534471441533, 20.295975020733223,
+	22.270848344890652, 23.711002619538146, 25.040317914286945, 26.4988864803024, 28.059257360328857, 29.62786651767163, 30.815567320695028, 32.389415272586994,
+	33.696368637661465, 35.17747432559351, 36.40301661189956, 37.98356041095427, 39.22059865200945, 40.495874348848005, 42.047691533553966, 43.29979705219434,
+	44.557449007744076, 45.819856630859604, 47.086346685812266, 48.35634304679467, 49.62934977913503, 50.92645207125851, 52.18273050439409, 53.462400323847696,
+	54.74365519031941, 55.73970881488741, 56.99499354276047, 58.31275794604547, 59.49178608417839, 60.61340258796748, 61.90937390222264, 63.193548131266475,
+	64.21665020691886, 65.50807487051536, 66.5375039539732, 67.82954415944982, 68.8641063176685, 70.1561852407066, 71.16570176846095, 72.2367997236591,
+	73.52854110440877, 74.57327687902202, 75.6204586817663, 76.91088020476481, 35.20841151814722, 0.0, 0.0, 13.420069336393366,
+	16.91222018628897, 18.898534471441533, 20.74297234895123, 22.270848344890652, 24.24742485176703, 25.782739848976064, 26.906406726066308, 28.462885749867596,
+	30.0230307273971, 31.585999701325452, 33.03024471405296, 34.33760486424221, 35.90970803184591, 37.17717773976351, 38.583083361670575, 39.91327504090313,
+	41.458348954212, 42.7231999106825, 43.96300059001073, 45.20938030028857, 46.793281102319284, 48.040367026139464, 49.29694282571167, 50.557515420662654,
+	51.78548000699877, 53.08856816691595, 54.358160769888414, 55.679214030589144, 56.903627895630436, 58.23960840677985, 59.45544790896589, 60.733092637059286,
+	61.727345216057145, 63.008946253420035, 64.2450213935881, 65.5731275788948, 66.58206046876387, 67.88658577315815, 69.09565393323582, 70.16784600589118,
+	71.45271818538222, 72.47588353914648, 73.76102166966591, 74.79340243948799, 76.0736017835159, 77.12679833470929, 72.16061432264699, 31.3970358385693,
+	0.0, 0.0, 13.420069336393366, 17.164419751559567, 19.376417670709525, 21.36606215152172, 22.78576515433189, 24.697295355116033,
+	26.221066193013204, 27.75366399100051, 29.293173033925594, 30.838082644899636, 31.983473366490887, 33.54255577544376, 35.144758202148324, 36.62913984767476,
+	37.85330468045344, 39.42143475390745, 40.62653714341709, 42.1977687952793, 43.41618962659325, 44.98856475687039, 46.21713655359296, 47.761294352717606,
+	49.08288276347899, 50.267468912654664, 51.51435975121182, 53.016438158522966, 54.3358730449573, 55.59017062215975, 56.8633004605272, 58.10753261257624,
+	59.399198560029895, 60.634069965030804, 61.899972099743145, 63.16725909761112, 64.4356838631795, 65.7050205054305, 66.69173828791455, 67.96479529968121,
+	69.23810323807241, 70.51150621118708, 71.60830427924094, 72.77302440464571, 74.05460262029615, 75.0712967009775, 76.34705990877961, 77.6220857312088,
+	78.6372509600798, 61.944483935266746, 28.652888970498662, 0.0, 0.0, 14.042977003434178, 17.59516300029674, 19.86990735019563,
+	21.6868321922483, 23.65624928910619, 25.155189564354718, 26.720040682544646, 28.188712545099907, 29.67972026854965, 31.254682521052302, 32.814743865781395,
+	34.340684764361214, 35.88848794774791, 37.43839463104739, 38.98973341780505, 40.21315748143603, 41.742083850523926, 43.28145278843749, 44.491800231758965,
+	46.04007534522672, 47.250711534191666, 48.812223685228034, 50.03260175572076, 51.358157708720306, 52.821482166652835, 54.054694264235316, 55.33046586799959,
+	56.85237063400992, 58.102470593106226, 59.3393794417426, 60.60928539085823, 61.83830052669905, 63.09129733891564, 64.34443087678008, 65.8971222579902,
+	67.15209761456143, 68.1687201538677, 69.37976307989886, 70.64044310706436, 71.90163630999066, 73.16316657445535, 74.4248703672984, 75.68659550830242,
+	76.94820008605416, 77.94132998052481, 79.2044343715106, 80.46699176616148, 55.084181917103265, 26.624824547404632, 0.0, 0.0,
+	14.426908775042746, 18.121161791033106, 20.271670324423205, 22.173362183393714, 24.13238556372575, 25.727042207264326, 27.117729049292265, 29.02788612626501,
+	30.580944455946025, 32.034051514285636, 33.622646330698544, 35.15175466785532, 36.73392089623648, 38.205145549113965, 39.75928166483647, 41.299178708775166,
+	42.840035244591995, 44.01368047305464, 45.56038460384722, 46.989570171704784, 48.3381910558727, 49.8484550447661, 51.053325905644975, 52.56059511573965,
+	54.14946468673506, 55.36478828600422, 56.64559472562742, 58.13216008785362, 59.38999102831517, 60.588335394887956, 62.13213259681836, 63.365451425525734,
+	64.60166659328782, 66.14085143082963, 67.30395428453521, 68.61856644234331, 69.86018342678697, 71.10328795691551, 72.34764012001602, 73.66783481698882,
+	74.8261442619677, 76.08603426643826, 77.3333025173356, 78.58085048149425, 79.81481954718078, 81.0126032119088, 82.32485854922, 50.28554284292468,
+	24.895406172175385, 0.0, 0.0, 14.92470543090354, 18.650579532604834, 20.878841299778845, 22.66366280067658, 24.701650565094404,
+	26.08339083049087, 28.02555706699247, 29.515013104530414, 31.448714899235792, 32.948119822846884, 34.522135742022655, 35.967919430638716, 37.485781086442664,
+	39.007541073936615, 40.53231950502264, 42.05935339948628, 43.58797864471184, 45.11761465682799, 46.647751557062996, 48.17793953777174, 49.6384182650153,
+	50.92306779885873, 52.42278754281577, 53.954231611584916, 55.48396577993615, 56.68253274256584, 58.212372231056904, 59.41839469441697, 60.94711361514649,
+	62.15897107409565, 63.68556577401764, 64.99006255480892, 66.42552712454751, 67.6451406358969, 68.86752355711518, 70.25861536492464, 71.6111892909592,
+	72.83729812626645, 74.0651164847768, 75.57791355401999, 76.80586687890913, 78.03491749719839, 79.26486728106008, 80.49553028754427, 81.72673193351123,
+	82.95830821328704, 76.30735223569917, 46.52061038185843, 23.34139679492063, 0.0, 0.0, 14.92470543090354, 18.650579532604834,
+	21.38382256913636, 23.640788645073844, 25.086280707053312, 27.011488319825396, 28.928808981267338, 30.399645043724657, 31.91983676453595, 33.79410301897373,
+	35.28486069792656, 37.18698992109261, 38.68301896587814, 40.275899639404486, 41.67113411591251, 43.19644211024238, 44.872426936503516, 46.58353801708039,
+	48.09128880365744, 49.599951912347244, 50.760253913963105, 52.41712686555676, 53.788019862601395, 55.300855707550426, 56.81259830219609, 58.32298768720752,
+	59.56993612990132, 61.020476981467816, 62.52946340493343, 63.98466071324807, 65.23287329022918, 66.73823004630331, 67.93968311384556, 69.44280583315401,
+	70.64788380319835, 72.14802404398887, 73.35574543094533, 74.85226879809255, 76.14069372070315, 77.5728184403059, 78.83950882668469, 80.08289460576323,
+	81.46333172962088, 82.6753149557877, 83.88819829457267, 85.36820745576767, 69.6152987731263, 43.3423164611901, 22.39075983336992, 0.0,
+	0.0, 15.808550306802235, 19.366201910058994, 21.88336800566139, 24.121379803275758, 26.024959878963745, 27.7316169476791, 29.37461766596456,
+	31.17568441703405, 33.15983652732889, 34.6288276508955, 36.53660326216922, 37.987908980879375, 39.8603751835456, 41.34271750089301, 42.82930466734432,
+	44.68890636085838, 46.17664450468224, 47.666777090401624, 49.27833211299425, 50.65212127464772, 52.48843114835207, 53.95279539467445, 55.468994469791475,
+	56.959277976245545, 58.449133718731744, 59.9382744250804, 61.4264376575566, 62.913383630361174, 64.09040912030233, 65.68237572535897, 67.06367415848649,
+	68.54726916907633, 70.02863395048395, 71.33580579799941, 72.69546091240346, 74.17258098654223, 75.64690781562776, 76.83811857316601, 78.30961025242924,
+	79.50280516762264, 80.97088796072572, 82.43564775740073, 83.62953925102013, 85.05428218156653, 86.33863840741748, 87.74069615168077, 64.47674857482232,
+	40.90243774463474, 21.157637622036162, 0.0, 0.0, 15.808550306802235, 19.755999594055947, 22.737741464225273, 25.054075456752354,
+	26.809238166586773, 28.36838917762518, 30.24783588223767, 32.120898156733155, 34.04454082944081, 35.84591300167924, 37.306958577764206, 39.15978188162111,
+	41.00414048123446, 42.47191137828259, 44.307460409944305, 45.77819683174309, 47.60350654737493, 49.07532550280915, 50.88935800249486, 52.36068902150776,
+	53.83318163355319, 55.632253630330325, 57.10231202861505, 58.572585393814386, 60.042792805348164, 61.51266837227874, 63.287297245074335, 64.73487225650588,
+	66.21643859148695, 67.67933813056207, 69.0761746970238, 70.60085978494776, 72.05914574185064, 73.51557718352124, 74.97001422737343, 76.42232700278709,
+	77.87239497237937, 79.32010628370845, 80.51182094979889, 81.94261373599684, 83.38533097993003, 84.82526711601703, 86.26234658575106, 87.44033948447094,
+	88.8739977725757, 87.15325220227646, 60.44247104367311, 39.00387479156006, 20.397955101506813, 0.0, 0.0, 16.76362609139211,
+	20.949364540427748, 23.31794559731736, 25.502209255031584, 27.36917055843, 29.647407395339734, 31.495564053476205, 33.338264862820125, 35.1751472649082,
+	37.005876310624714, 38.600479260317, 40.28490820255705, 42.103425417640665, 43.9145726601069, 45.701719752929534, 47.16840759579706, 48.9714553512144,
+	50.75885701811284, 52.2160045724209, 53.96825282569537, 55.44990769128188, 57.21731829868448, 58.6857297193267, 60.42792044001134, 61.87923213746026,
+	63.62431119463118, 65.07205579404948, 66.80543727255414, 68.24898105009551, 69.69146934153244, 71.13276130701581, 72.8467095176494, 74.2812953646433,
+	75.71711683033502, 77.14981743187371, 78.84307832059231, 80.26966724902759, 81.69423882232518, 83.11669627018978, 84.53694752997569, 85.93471267920135,
+	87.37048664634297, 88.78361353969042, 90.1942120679914, 91.6022126013134, 81.22285578535731, 57.237352195837744, 37.216020982892424, 19.686993016317626,
+	0.0, 0.0, 16.76362609139211, 21.575101671730835, 24.251821397223953, 26.354296488581884, 28.6027634802342, 30.45295850143312,
+	32.27620053386407, 34.471089637191525, 36.20337378568825, 38.09577095314549, 39.89871489711823, 41.695328660093054, 43.485523196506385, 45.269203042423975,
+	47.046276668100454, 48.81666187125889, 50.58028825489761, 52.33709800917446, 54.08704572986102, 55.830097714461246, 57.56623100094311, 59.009572832433115,
+	60.736579570935255, 62.45650281706883, 64.16935745376581, 65.60426339470996, 67.3073590820246, 69.00332477506913, 70.39392555749703, 72.11695634589739,
+	73.54001844706666, 75.21552694980426, 76.63356576823614, 78.29855252368495, 79.71127985255579, 81.36560737074768, 82.77275793132574, 84.41632604939163,
+	85.81766017479427, 87.45040088570572, 88.84570480052842, 90.2386805280526, 91.85666223394422, 93.24331876808294, 94.62750573144207, 76.35642230689831,
+	54.610464503855304, 35.77074570770119, 18.852913143024853, 0.0, 0.0, 17.8557931899869, 22.034182937855665, 25.220635300165373,
+	27.55504896780358, 29.350969125211538, 31.535097757694334, 33.465725709183076, 35.50657334117566, 37.31039015800867, 39.424288535421965, 41.209745440490316,
+	43.29105896479337, 45.05886050859192, 46.8195592750936, 48.85984459679224, 50.60361446548247, 52.34064433698365, 54.182139371574195, 56.062491980567614,
+	57.77656127183217, 59.484153631395905, 61.185317768060855, 62.88009190265425, 64.56850726815304, 66.25059059805824, 67.94428103206182, 69.59585563406277,
+	71.2590816921817, 72.91606586551333, 74.56683029368368, 76.26568968321222, 77.84979153846945, 79.48203606393207, 81.1081563569908, 82.72817830924917,
+	84.12611047618174, 85.72548658700802, 87.34098626547998, 88.93934796022751, 90.53169904853621, 91.91250437602729, 93.49529407591002, 95.0721150690363,
+	96.44271005478838, 96.6399499788484, 72.50067042735377, 52.05268012110608, 34.37904712882934, 18.321600968125267, 0.0, 0.0,
+	18.84503275553074, 22.876940528851005, 26.221422969354297, 28.37197284513096, 30.39313755699222, 32.797355935666225, 34.62014633777956, 36.72216514890122,
+	38.79373233128097, 40.865847154152355, 42.90929424192741, 44.93487192368931, 46.697698229612826, 48.69628359865074, 50.67860045158958, 52.41216126321938,
+	54.36971399840069, 56.085399480733635, 58.0192748331709, 59.93883275368499, 61.629305124917416, 63.526714264077995, 65.20144420629813, 67.05778067828071,
+	68.73708012248049, 70.3898033706399, 72.23742586664481, 73.87574501031432, 75.70356551159676, 77.3278596476181, 78.94586291044492, 80.74709033322719,
+	82.35155975418844, 83.94998420207655, 85.7255332529177, 87.3108355465602, 88.89028816174024, 90.64101031024884, 92.20770112989186, 93.76870488205827,
+	95.32407509078334, 97.04311926319917, 98.58698296089914, 100.12450193650405, 91.29358760873623, 69.30634427627365, 50.102822482145626, 33.31088795298714,
+	17.81321677389008, 0.0, 0.0, 18.84503275553074, 23.550273293949648, 27.250656114858536, 29.630631058942466, 31.809861143077214,
+	34.01407637986414, 36.17135506759347, 38.20380223226652, 40.43212598174611, 42.45548223653135, 44.467205330560496, 46.64490561771622, 48.62056300903208,
+	50.580179038814755, 52.52452398882522, 54.45426621385676, 56.53717307370535, 58.43742776691871, 60.32460326376234, 62.199141472357034, 64.06144188612826,
+	65.91186817495765, 67.75075344615657, 69.57840448848474, 71.39510523057956, 73.20111958645589, 74.99669381821035, 76.78205851492665, 78.55743026379396,
+	80.32301307229302, 82.1057060080279, 83.84808564329016, 85.56290369973607, 87.29115859312545, 89.01049328742322, 90.72105697092465, 92.42299211262183,
+	94.11643495433155, 95.80151595174164, 97.47836017127091, 99.14708764852907, 100.80781371322722, 102.34064900700164, 103.98709113468304, 86.96825329645714,
+	66.36740616862939, 48.49459463603795, 32.28989503491715, 17.330859747773584, 0.0, 0.0, 19.6728658819917, 24.759869092431877,
+	28.17208095047186, 30.815716130792875, 32.900489942474536, 35.259806774495175, 37.57426533742048, 39.84895849038253, 42.08793350067212, 44.29447279991051,
+	46.31356312271578, 48.47234813401111, 50.61080014120787, 52.72338463499239, 54.701924847520175, 56.77743205262767, 58.83056376410107, 60.77815755311858,
+	62.796979556997854, 64.72424301167577, 66.71025919309798, 68.6781365669253, 70.57092945451382, 72.5088971224659, 74.38023693105164, 76.27489192665074,
+	78.13977501849307, 80.02232370231005, 81.8512763527873, 83.70814817812956, 85.51642389197438, 87.31457950935302, 89.13611830450877, 90.91275737053448,
+	92.71148096727646, 94.467436741455, 96.24438879515876, 97.9804968452474, 99.73661204047403, 101.48196975823176, 103.18974836925359, 104.9155529982545,
+	106.63128424975054, 105.57920500094723, 83.22078610018045, 64.00059552260666, 46.999478889905404, 31.559476788283586, 17.100519036064195, 0.0,
+	0.0, 19.235069167719157, 23.211292258856044, 26.94987515906514, 29.79138524753589, 31.40229839400812, 33.94820501826505, 35.762163627481584,
+	38.23924621675521, 40.14276135513662, 42.100133490351396, 44.09168315036119, 46.10410553088909, 48.12813430064936, 50.15717282902065, 52.18589899960072,
+	53.943227679921634, 55.95202339002663, 57.94316717505461, 59.66798442312181, 61.63254437194668, 63.58106150483478, 65.27590418688206, 67.19998727044894,
+	68.8758773853031, 70.77662590593218, 72.43465395516104, 74.31304222627561, 75.95410077656881, 77.81100297181476, 79.4358394246083, 81.27204715356355,
+	82.88129955957473, 84.48301959195683, 86.29175682048907, 87.87874331515509, 89.6683605494262, 91.24106156545095, 92.80695432058168, 94.57097254554965,
+	96.12318751070873, 97.66893347998696, 99.4082729474143, 100.94085280042985, 100.99058209815405, 80.28362303171025, 61.955939612828466, 45.72157109186036,
+	30.86311409547526, 16.663898828208414, 0.0, 0.0, 17.454543950628015, 21.784600370586457, 25.764659628088538, 27.840524785856424,
+	29.80440159480534, 31.86101697205261, 33.97217474120555, 36.114413984123466, 37.84139172079027, 40.04734978750469, 41.86253901431501, 43.66852950885995,
+	45.464789760651215, 47.6132441445985, 49.386735021870365, 51.151335452583034, 52.90729138874424, 54.65480555363705, 56.394049269601325, 58.12517074229978,
+	59.84830092243365, 61.56355769264077, 63.27104888460257, 64.97087447141851, 66.66312817352099, 68.34789864428734, 70.02527035226127, 71.69532424293132,
+	73.35813823937605, 75.01378762445617, 76.66234533549759, 78.30388219400096, 79.93846708689526, 81.56616711149192, 83.18704769313258, 84.52488579025751,
+	86.1352935584472, 87.7389994615482, 89.33606529216722, 90.92655148148087, 92.24617682605688, 93.82639600149295, 95.40014672703619, 96.96748654665171,
+	77.57598450093184, 60.23336199497062, 44.512742069381765, 30.204529690921362, 16.45865446768293, 0.0, 0.0, 17.454543950628015,
+	21.784600370586457, 23.76847191676011, 26.203344595557432, 28.409908643715013, 30.089639386952058, 32.406645150419905, 34.2693769910692, 36.138442574743884,
+	37.996966618932014, 39.8451376387717, 41.68312862465496, 43.09954001696676, 44.92539727521233, 46.74122094026585, 48.54717696581204, 50.3339669685352,
+	52.130131392178484, 53.533738725813045, 55.308019380378894, 57.07295897118073, 58.46958943457911, 60.22195312963485, 61.96521860319777, 63.35388171059255,
+	65.08453725710257, 66.80635602973676, 68.18622674126227, 69.89549165871539, 71.2699121945582, 72.9666121896707, 74.33529128850326, 75.9542508799227,
+	77.38212993782187, 79.05379569599158, 80.4102547677987, 81.76461782000545, 83.4195448159035, 84.76737088077525, 86.40992358373954, 87.75106223382554,
+	89.08981917892724, 90.71567672270899, 91.96986688195473, 75.15765689607461, 58.6749104524771, 43.673110145039836, 29.587751856690446, 16.077085160960735,
+	0.0, 0.0, 15.657998492087861, 20.57199542391259, 22.914480813413466, 25.475935904474266, 27.176645733800086, 29.04422315341466,
+	30.967238511143215, 32.40277618496785, 34.31357653835153, 36.21179512014066, 38.09772697739218, 39.5323377948606, 41.4036122535449, 42.8381748176307,
+	44.694219924487676, 46.538570553944574, 47.967724654974916, 49.796784017796845, 51.222748513246145, 52.64910088795802, 54.45828369836108, 55.879994644176556,
+	57.67361124049326, 59.09003262111151, 60.505614495700755, 62.27884631861807, 63.68827063886589, 65.09631719568067, 66.50280924265478, 67.9075818857249,
+	69.64984367870063, 71.04737053341617, 72.44282690787067, 73.83609046926871, 75.22704785018159, 76.63790999604981, 78.00163226392384, 79.7017074814105,
+	81.07964266075324, 82.45488272011919, 83.82735546512927, 85.19699414443313, 86.56373706694774, 87.9275272421634, 73.21218730486878, 57.261337058350556,
+	42.71035183939848, 29.017107814718205, 15.901951667332728, 0.0, 0.0, 15.657998492087861, 19.52372528181761, 22.137375151030145,
+	24.169046291121205, 26.07462702681626, 27.528808083818657, 29.490727407898348, 31.438083448730005, 32.89630411156829, 34.826132833854814, 36.28568777646411,
+	38.19746936320003, 39.65603631970408, 41.117055202993264, 43.005484100316075, 44.462749283602726, 45.92087140782494, 47.35726269302003, 49.237864231535944,
+	50.69019151910821, 52.141893133694275, 53.59264368912767, 55.04215156469361, 56.490155505184156, 57.936421424949096, 59.3807394767336, 60.82292140434503,
+	62.262798174981725, 63.70021787484329, 65.13504384594961, 66.56715304018566, 67.99643456680027, 69.42278841094114, 70.84612430268636, 72.2663607181188,
+	73.68342399605821, 74.7724853022724, 76.1860691924099, 77.59621826169953, 79.00288714573503, 80.092844940613, 81.49519182060969, 82.89388122621237,
+	84.28888633968636, 71.5465565602436, 56.21685058389223, 41.88135348037905, 28.664571427707756, 15.708757491339565, 0.0, 0.0,
+	15.657998492087861, 18.607685943862943, 21.42711461530687, 23.026508729848793, 25.03020914395102, 26.51091773360293, 28.49595253408154, 29.97937183252081,
+	31.94378480631723, 33.42671577056315, 34.9131858003027, 36.402175979767314, 37.89281341655858, 39.8169402572083, 41.30140895641308, 42.78599337599728,
+	44.27019169817272, 45.75356932024576, 47.23574892980531, 48.71640205691145, 50.1952419398355, 51.67201752195977, 53.14650840545692, 54.24484485636502,
+	55.71876399453726, 57.18970972610537, 58.6575601244371, 60.12220714936259, 61.231412404267665, 62.64028889428039, 64.15129473255901, 65.60580617082805,
+	66.7191588819803, 68.16953296047933, 69.28536499113564, 70.7310426359706, 72.17270562745473, 73.28893989067717, 74.72533987281074, 75.8420441334036,
+	77.27282964599547, 78.38935209115981, 79.8142323328922, 80.93000751773256, 69.95711856813456, 55.23769800476509, 41.35149811384237, 28.181164245170148,
+	15.446764275709361, 0.0, 0.0, 14.288067486627785, 17.801967397063965, 20.775897550625594, 22.493527900016748, 23.993919637212407,
+	26.00461612062882, 27.506159689951467, 29.012944377182965, 30.52345197487077, 32.03644448745329, 33.550909678587715, 35.06601540867815, 36.58107303125189,
+	38.095508608875484, 39.60884043229041, 41.120661467664696, 42.6306256055558, 44.13843682939753, 45.643840627038415, 47.146617131415006, 48.28078638898397,
+	49.764140728668565, 51.26259271584484, 52.388457766657346, 53.88410532242386, 55.37599318460776, 56.508013993229035, 57.99586404252637, 59.13184086752514,
+	60.61494575204583, 61.75358619383957, 63.23138899826926, 64.37162071431976, 65.84367907998106, 66.98460493907861, 68.12733032948807, 69.5914282927061,
+	70.73364337067822, 71.87695579070902, 73.33228637722571, 74.4741526985036, 75.6165560400472, 76.75930550751063, 78.20274865552184, 68.63174359341255,
+	54.3309238062525, 40.69109286748076, 27.862892500474164, 15.361452002007752, 0.0, 0.0, 14.288067486627785, 17.801967397063965,
+	20.177548086571562, 21.967763401355636, 23.483552673734305, 25.005812567284014, 26.53255308801914, 28.06222707197897, 29.593620038631602, 31.125767333442017,
+	32.6578932309357, 34.1893664083729, 35.7196672727007, 37.24836379086631, 38.36278333933767, 39.89314559755121, 41.42067297626698, 42.552391590254395,
+	44.07855429032326, 45.601186937556136, 46.74362716585857, 48.26301299037425, 49.410906568909695, 50.92716769792249, 52.08084934176604, 53.59095537787523,
+	54.747749365977946, 55.907962845050996, 57.41092166870713, 58.572276627552235, 59.73590857081878, 61.230506868208906, 62.393842088614626, 63.55859098535614,
+	64.72446357848753, 66.20737074022824, 67.37149520519742, 68.53613841940053, 69.70109054114523, 70.86615885239797, 72.03116608291309, 73.19594891748476,
+	74.36035666573466, 75.52425007593794, 67.53892238944493, 53.51100126023861, 40.26558508757532, 27.629105558252785, 15.208471002175516, 0.0,
+	0.0, 13.222070669240964, 17.090987095906886, 19.10455020475046, 21.11718576474409, 22.48887235145399, 24.06355177251893, 25.58630389930344,
+	27.1365088493, 28.686541967162388, 30.23575573968601, 31.785160155640252, 33.32972344469318, 34.45781800696659, 36.005063035387224, 37.54933575687983,
+	38.69488404227396, 40.23725471884533, 41.39298087669725, 42.93194876942839, 44.094904947672255, 45.26386264721231, 46.79737769119856, 47.97007263718953,
+	49.497919514709864, 50.67289607675164, 51.85102366856524, 53.031792631427756, 54.549251973638285, 55.729806290569705, 56.91199706123271, 58.09548497295165,
+	59.279964993697504, 60.46516219785949, 61.650828155548695, 62.836737806822455, 64.02268675255938, 65.20848890305149, 66.39397443371915, 67.57898800461702,
+	68.76338720669976, 69.94704120322973, 71.12982953933826, 72.3290623658781, 73.4923731736678, 66.52827078587937, 52.76288442558989, 39.87047027676604,
+	27.396083045571697, 15.110904845750483, 0.0, 0.0, 13.222070669240964, 16.463300623741148, 18.60102998895995, 20.451471336720367,
+	22.00880625415306, 23.56764898894543, 25.126962520899866, 26.68595300841265, 27.8101062809421, 29.373096261030206, 30.93382560977911, 32.08293770369356,
+	33.64300847782653, 34.805903540429576, 36.363283853599995, 37.535712890577585, 39.08909489880664, 40.268053982597664, 41.81654571244842, 42.99983797659014,
+	44.1877142606461, 45.37942119996017, 46.91830414624312, 48.11080549130827, 49.30574392123043, 50.5026570495554, 51.70113788000653, 52.90082678801386,
+	54.07746536668815, 55.302587857246245, 56.50412218187789, 57.70578010133563, 58.9073567023212, 60.10866691518788, 61.309543043077255, 62.50983265168177,
+	63.414933935930435, 64.61607658300305, 65.8161606716528, 67.0150857084013, 67.9291363083326, 69.12751726531773, 70.32441791328648, 71.43224928936999,
+	65.71754462938262, 52.237292407897506, 39.52099355742735, 27.19372182805344, 15.020516127105017, 0.0, 0.0, 13.222070669240964,
+	16.463300623741148, 18.117611183983488, 19.975321910049786, 21.543140707972288, 23.111182526590998, 24.24304344093597, 25.815415399314706, 27.407701821537767,
+	28.544599526918734, 30.114300812984553, 31.287521080109737, 32.854418235229346, 34.03733741037372, 35.60020553268517, 36.78958597446298, 37.98505834204692,
+	39.18554430468136, 40.73942744723393, 41.941552205245436, 43.1468401799294, 44.3546889623034, 45.564581086431154, 46.77606959817571, 47.98876631720039,
+	49.202332266776786, 50.41646984857078, 51.63091642357337, 52.84543902818549, 54.0598300088069, 54.97306327397165, 56.18932812600464, 57.40483504016582,
+	58.61945798307054, 59.833081936990844, 60.759293767414235, 61.97267666508842, 63.18469711883294, 64.11698602727893, 65.32783362544409, 66.26417008244695,
+	67.47329852790614, 68.41270590709637, 69.61966760294948, 64.97246808520144, 51.775006497253614, 39.20375248896388, 27.003754856558334, 14.96226017568443,
+	0.0, 0.0, 12.395146924028147, 15.91027273156453, 18.117611183983488, 19.51841792530233, 21.094093261078633, 22.66913119998978,
+	23.821416821429477, 25.39787382741924, 26.57058827630974, 28.145337661070112, 29.33099593417731, 30.902468038110836, 32.09650035139896, 33.298021166462206,
+	34.50556757563581, 36.06831051979463, 37.27801831144526, 38.49143618197393, 39.70782429092246, 40.92656340105245, 42.14713136594606, 43.36908460744207,
+	44.592043477330286, 45.81568064542351, 47.03971185032598, 47.958826929729575, 49.18585269971283, 50.41244400096703, 51.638446263692586, 52.86372035472236,
+	53.79934426260342, 55.02488730061877, 56.249270456665855, 57.192422207899746, 58.41595214601488, 59.36402280489181, 60.58606256894684, 61.53784342718126,
+	62.75788874471667, 63.695591443764336, 64.93003236994117, 65.8864235476163, 66.84503018793217, 68.05893335149617, 64.36034581104548, 51.38138980079774,
+	38.96823039269102, 26.886747962866362, 14.912448609826276, 0.0, 0.0, 12.395146924028147, 15.424276757795484, 17.5744642699388,
+	19.083625946173818, 20.663932906682785, 21.835613793498656, 23.41535982703067, 24.63965913789597, 26.18161218655861, 27.381250208229602, 28.954827331967316,
+	30.160958771502163, 31.373813605582644, 32.59195407529, 33.81424317331718, 35.09817722331509, 36.267813638382016, 37.497768232774064, 38.72914730049476,
+	39.96154336424494, 41.19461388510395, 42.42806783917323, 43.66165538703478, 44.89515987939746, 45.83372918754195, 47.06888716903533, 48.303318742348154,
+	49.25300272183732, 50.487426271039354, 51.7207246719976, 52.677580528763514, 53.90978719906696, 54.8713766038443, 56.101888188103096, 57.06693971796733,
+	58.18099908443301, 59.26278503933188, 60.23293049133859, 61.457717950035, 62.42895920194163, 63.40202762586884, 64.6224979469552, 65.59567481147091,
+	66.57005158151054, 63.884373723055404, 51.07844284680421, 38.76931133085717, 26.7848491487373, 14.879173065793537, 0.0, 0.0,
+	12.395146924028147, 15.424276757795484, 17.226013866709444, 18.673887656770034, 20.2549824956914, 21.447915167779758, 23.026419837489392, 24.231246818657862,
+	25.8065171987974, 27.01825041733536, 28.237354159648717, 29.462060806157186, 30.691028336743212, 31.923226918818653, 33.15785690811106, 34.39428944026653,
+	35.63202322992201, 36.87065300855001, 38.1098463445074, 39.34932652439561, 40.58885983809841, 41.53722106321124, 42.77848458968625, 44.01912089952925,
+	44.969305676400836, 46.21983987373484, 47.18727254804652, 48.426828272743194, 49.399535467089265, 50.63744854666336, 51.61391192242273, 52.84971600751088,
+	53.828759853238445, 55.062106566088964, 56.042805569193135, 57.02579146121689, 58.01070059676341, 59.23845954629243, 60.22338033661475, 61.20952949526787,
+	62.19666273630041, 63.18456067849354, 64.40396575158552, 65.39058965201579, 63.45716165477944, 50.840501729024034, 38.621466422506124, 26.718987122216983,
+	14.864735212405552, 0.0, 0.0, 11.768844318878136, 14.989964403205088, 16.82557936503898, 18.673887656770034, 19.869615937226804,
+	21.082161592081526, 22.656171785452003, 23.875355017188994, 25.10236382955712, 26.334997073588024, 27.89763874953181, 29.13030246102125, 30.366087069167406,
+	31.60416757686282, 32.54007910166964, 33.784746638555745, 35.12608994639753, 36.27479018875177, 37.51974068555449, 38.49425344477783, 39.7287657026894,
+	40.979830765601854, 41.947697936380166, 43.19226467390736, 44.172521605166764, 45.415628429792726, 46.400434520966336, 47.64156343140333, 48.629432557404144,
+	49.620627313630834, 50.85804352770453, 51.85046545804368, 52.84504682765009, 54.0780914277143, 55.07269805722713, 56.068651630465794, 57.065667246636,
+	58.063493185450554, 59.06190650805363, 60.212258440835804, 61.28133702108315, 62.27836052249787, 63.27542275398214, 64.272387338736, 63.17571754577339,
+	50.658977990032284, 38.536060484406754, 26.689957570402683, 14.866797914492137, 0.0, 0.0, 11.768844318878136, 14.718096526295145,
+	16.82557936503898, 18.292212459345276, 19.602621562283538, 20.740315711604293, 22.306213692680302, 23.538160640722978, 24.775534740677042, 26.01663689360693,
+	27.26028540599692, 28.50564815962519, 29.752130244089596, 30.99929843299876, 32.246830195221406, 33.214431668178655, 34.463844019055024, 35.71277833993824,
+	36.961155471613544, 37.94282689087863, 39.190478567731134, 40.17947311555096, 41.42552951971024, 42.41936135536068, 43.663322314615634, 44.66024789120304,
+	45.6607017919157, 46.900585771081545, 47.902063817938966, 48.905730658882774, 49.911139553786, 51.144470967973845, 52.149202530352284, 53.15492241149675,
+	54.161359297053394, 55.16827686806415, 56.1754687203244, 57.1827540610347, 58.189974059189794, 59.19698874629854, 60.203674380880614, 61.20992120426857,
+	62.215631526981085, 63.220718094742615, 62.95384044576298, 50.537412018998225, 38.50882814362734, 26.696501048740515, 14.889454740780046, 0.0,
+	0.0, 11.768844318878136, 14.531782302411717, 16.46070504769013, 17.941657868285052, 19.179320497239882, 20.740315711604293, 21.978159080926744,
+	23.221004947326918, 24.467037192744677, 25.71505951092203, 26.96427733132541, 28.214156859346193, 29.464333656931416, 30.442311575940234, 31.693952360537324,
+	32.94501817282931, 33.93730655062868, 35.11740139457593, 36.18750313047535, 37.43535416120261, 38.44046259727616, 39.68582609901429, 40.69371976582929,
+	41.936439698180806, 42.94574251681255, 43.99519865230787, 44.97176939775077, 46.20729484982879, 47.2205663688722, 48.234958150161724, 49.25013369733446,
+	50.26580740979844, 51.28173625248307, 52.297712838286664, 53.313559675981416, 54.329124380594315, 55.344275679592116, 56.32498783208281, 57.37289906964671,
+	58.38618680262208, 59.39868812136538, 60.21849989084277, 61.24795897034484, 62.24088422682439, 62.81129479315575, 50.49917643968261, 38.51269725834522,
+	26.718605516741427, 14.929920320665245, 0.0, 0.0, 11.768844318878136, 14.255524520514815, 16.135431053950978, 17.625295033230834,
+	19.179320497239882, 20.424351193850423, 21.620237371969644, 22.925230336474783, 24.177997390622714, 25.431219200545407, 26.684469649212186, 27.672521333796116,
+	28.893805198038198, 30.183105020891226, 31.18901682110717, 32.43974372996694, 33.689709194740814, 34.70077285694239, 35.9479169793443, 36.9625406673731,
+	37.970406215246975, 39.223017865507416, 40.19322813384817, 41.26413424207558, 42.287522815298686, 43.5207161741153, 44.54252408007914, 45.565086596959326,
+	46.596071844801266, 47.61120292260438, 48.63425270945954, 49.65703048812398, 50.679377626329774, 51.70116147849109, 52.722270795637044, 53.742611955343264,
+	54.57624550622777, 55.5958267652711, 56.61433683628363, 57.57098652399014, 58.64799767841851, 59.484807277518414, 60.499181700305975, 61.43487311518898,
+	62.35027579014982, 50.516677538095394, 38.557798078044655, 26.786167248137936, 14.973821458447391, 0.0, 0.0, 11.429939638725196,
+	14.255524520514815, 16.135431053950978, 17.625295033230834, 18.87926778412103, 20.136232371771467, 21.394220991134986, 22.652169215577533, 23.909533110779005,
+	25.166063519166585, 26.176687707014946, 27.43144124064708, 28.684978363817162, 29.705898934763663, 30.955684594343712, 31.981927899080862, 33.227672145830155,
+	34.2559808946579, 35.49793231300812, 36.52646919794048, 37.557554485076665, 38.59034678067606, 39.82217885686205, 40.852700820614686, 41.868710087145004,
+	42.915172449870795, 43.946419015653795, 45.002567116329715, 46.00767389121653, 47.03732426479054, 48.06614376736413, 49.09403164869703, 50.12090777475073,
+	51.1467084524621, 51.99706946904542, 53.021253977110995, 54.04414337717644, 55.06572459638461, 55.91789626165668, 56.93703518252693, 57.95479729912502,
+	58.80720511368658, 59.8221647664198, 60.67503363049751, 61.66619771402465, 50.57910870289117, 38.66152666928716, 26.870409062587534, 15.008539668230185,
+	0.0, 0.0, 11.241776937267705, 13.965975106434335, 15.853746037227648, 17.34615721587681, 18.612486830977154, 19.87788739072135,
+	21.141521723453057, 22.403130147338874, 23.66274366373782, 24.69551745362353, 25.950296947357288, 27.20342240012965, 28.241419938450512, 29.48893070179587,
+	30.52955093891871, 31.771818056774926, 32.812214289597854, 33.85538326354828, 35.088758771439615, 36.12917847557691, 37.170467477987465, 38.21201612275337,
+	39.434514059361945, 40.472292520989456, 41.50969704830949, 42.546466006221586, 43.58239876983207, 44.61734253692488, 45.48239583426511, 46.516115089317076,
+	47.54839417205007, 48.57921291698411, 49.543821337256574, 50.47651494836768, 51.50306575521656, 52.517258155515705, 53.46366274312274, 54.418207874862965,
+	55.4384550492047, 56.30539207296537, 57.32221060626444, 58.188620546986876, 59.201925579377004, 60.067189905097685, 60.93305166219174, 50.69833208989326,
+	38.79125105529668, 26.96147601643187, 15.06821180679053, 0.0, 0.0, 11.241776937267705, 13.965975106434335, 15.853746037227648,
+	17.107170152804393, 18.381285336373544, 19.651174798037136, 20.91705171707154, 22.1793812930105, 23.43869804449558, 24.49191646274876, 25.74326020776577,
+	26.99296286188526, 28.044178067490357, 29.100750250099722, 30.33762314837935, 31.391124141663422, 32.62281548028816, 33.672419727888034, 34.72259997350047,
+	35.772636721875855, 36.82200172128621, 37.8703118911299, 38.91729417981584, 39.96275872460927, 41.006578325798245, 42.04867273272183, 43.0889966049206,
+	44.127530286822754, 45.16427274138424, 46.1992361440048, 47.08349792648267, 48.114893416396434, 49.14449903420152, 50.02827451865682, 51.05390710955473,
+	51.93761483288243, 52.95911673836594, 53.97902116764697, 54.85911329831682, 55.76968874577293, 56.75325073360632, 57.63187318317196, 58.641135837089855,
+	59.517148269225686, 60.39290433711057, 50.90698383451297, 38.97274187867458, 27.12781878314545, 15.166213233530437, 0.0, 0.0,
+	11.02172175559515, 13.735753893671898, 15.619470432358199, 17.107170152804393, 18.381285336373544, 19.457844057926405, 20.722254048793914, 21.982131335196826,
+	23.238421378352687, 24.31055832187175, 25.556305135345024, 26.62724354053115, 27.864022087088127, 28.930481105696778, 29.99812096855868, 31.22127665409695,
+	32.31138943667366, 33.34261247452945, 34.40154722417245, 35.45871145244134, 36.51383371808697, 37.566746542544664, 38.61735817981909, 39.66563151489159,
+	40.71156828642495, 41.7858259379207, 42.79656561561009, 43.83573203660832, 44.73723235614441, 45.77171115460551, 46.80410346923582, 47.703276426977794,
+	48.73077978999177, 49.62877739410396, 50.65136878399147, 51.54724148959553, 52.56503082221567, 53.45814299106793, 54.471312226249, 55.361247454961905,
+	56.25077484211415, 57.25650677754047, 58.142335269166324, 59.0272908453683, 59.91119785480346, 51.130165351900686, 39.19717754641554, 27.33355044168725,
+	15.295656346904519, 0.0, 0.0, 11.02172175559515, 13.735753893671898, 15.436107685268352, 16.91106509039675, 18.187824316281006,
+	19.457844057926405, 20.558460759249005, 21.81250826811286, 23.057624674299646, 24.15222350434509, 25.39011908092928, 26.473163645397996, 27.701465197972894,
+	28.776683841249593, 29.85132045997222, 30.924305178054507, 31.99493377612057, 33.196315112052716, 34.25854860826979, 35.3182779116955, 36.37535394691008,
+	37.30272964709957, 38.35483152639226, 39.403929154853834, 40.45013982141399, 41.493589174619274, 42.414944166848606, 43.45224988101815, 44.48708912241655,
+	45.403668903420744, 46.43246286453983, 47.346413214948356, 48.36932860453841, 49.279787524530306, 50.297114127371515, 51.20355830006243, 52.215640594689994,
+	53.11777422165659, 54.018943408552865, 54.91889885282456, 55.91915206077083, 56.814285885727735, 57.69185891937462, 58.59983765895272, 59.490017538400544,
+	51.49678164020869, 39.449343527727066, 27.513666144908434, 15.419620449040496, 0.0, 0.0, 11.02172175559515, 13.569854970415035,
+	15.436107685268352, 16.760277863745184, 18.034051933230064, 19.28145340881825, 20.558460759249005, 21.671536671448013, 22.91296471915135, 24.017625415038925,
+	25.24534011006961, 26.338989216826505, 27.431034742781485, 28.639767541035646, 29.720297530193495, 30.797813568234925, 31.871859673999918, 32.929996040547344,
+	34.00873076177858, 35.071476144131665, 36.098416878123565, 37.18595823403301, 38.13088444678878, 39.17872444841655, 40.197506820169416, 41.26476054651416,
+	42.19975294744282, 43.23082443547672, 44.165460473852384, 45.19244190448763, 46.11956961493651, 47.13985113843128, 48.06190353786804, 49.07598828572805,
+	49.992668774874545, 50.90796891463998, 51.82160627208103, 52.82189609472125, 53.710873623541715, 54.635530255121985, 55.53924831788626, 56.44075069567212,
+	57.33995715782243, 58.321424032765705, 59.21500249024194, 51.8145031639307, 39.759397424424535, 27.71499950477249, 15.558186695040547, 0.0,
+	0.0, 10.863732731857338, 13.569854970415035, 15.306671537554147, 16.760277863745184, 18.034051933230064, 19.177511261873704, 20.42686036594686,
+	21.56975315230255, 22.789475161994062, 23.907397967280822, 25.122547754382413, 26.225198238615004, 27.324004665982333, 28.418047719182308, 29.506848897323934,
+	30.590224619101658, 31.668185730452326, 32.740868228956685, 33.80848552468624, 34.871295618430594, 35.92957865289549, 36.98362170098116, 37.9465559007768,
+	38.990976748395866, 40.03187922668337, 40.984761761877145, 42.020366942817404, 42.963889704183245, 43.98768143104137, 44.92824837799407, 45.91534480044169,
+	46.87826766276278, 47.81006134641633, 48.81465798905281, 49.73939420318004, 50.6617246953002, 51.581472139948865, 52.57162148213424, 53.484504174403234,
+	54.39471833348913, 55.30219858006192, 56.20690191841794, 57.10880379444932, 58.00789478689432, 58.904177829404595, 52.2802144339551, 40.08739673715644,
+	28.01682525885285, 15.74801478359799, 0.0, 0.0, 10.863732731857338, 13.569854970415035, 15.306671537554147, 16.683289995485012,
+	17.921632828742688, 19.177511261873704, 20.32846479283565, 21.524017405147834, 22.69310180274076, 23.822083390946425, 25.02225376517934, 26.13221134711133,
+	27.236249964729108, 28.33388345966705, 29.42497442324291, 30.50960885469108, 31.588011560430267, 32.660489095770075, 33.717496087562786, 34.78908624338083,
+	35.773693010137606, 36.82374634192112, 37.869532301241165, 38.850839172753034, 39.87671264058682, 40.841282754706576, 41.866737196620676, 42.82317043829007,
+	43.839854780801055, 44.78155033376381, 45.73339463741403, 46.736681871990044, 47.673709217831814, 48.60769148462512, 49.538461554637514, 50.46590171538958,
+	51.389934456976704, 52.310514881820446, 53.2842523747166, 54.19730196690664, 55.107068473522986, 55.95823518072563, 56.86163955691193, 57.76172292371979,
+	58.658542776827744, 52.737704203849766, 40.54504622809475, 28.35556393957685, 15.939409212394617, 0.0, 0.0, 10.863732731857338,
+	13.472316330486862, 15.23350475865414, 16.64227859373428, 17.851877936242186, 19.093051065449256, 20.264077568349585, 21.478989163543133, 22.624410406174672,
+	23.762120754684332, 24.944893168755282, 26.06038517042827, 27.15676029298952, 28.25747979247458, 29.360533569001248, 30.444715941243462, 31.52252027814942,
+	32.5410852971971, 33.6039765294605, 34.66108782028016, 35.71293834248238, 36.70718877559941, 37.746242348224996, 38.73101963731538, 39.75842998556666,
+	40.73066785045098, 41.75009295408237, 42.714426690670436, 43.67414760138245, 44.67679031367136, 45.62751909099962, 46.57440894660611, 47.517311267030486,
+	48.456133269402144, 49.406983994565536, 50.32137896605062, 51.24780513029091, 52.169435014163504, 53.094274223889194, 54.00277986986573, 54.91322399500499,
+	55.81986118271181, 56.72278017544908, 57.62207285095386, 58.479425395992536, 53.351612282307364, 40.88021465698086, 28.574534190481664, 16.079055130990017,
+	0.0, 0.0, 10.863732731857338, 13.472316330486862, 15.23350475865414, 16.602274452470798, 17.851877936242186, 19.046964237955518,
+	20.264077568349585, 21.42873796244306, 22.58382726440356, 23.762120754684332, 24.890815947791197, 26.008509707411473, 27.119702597280625, 28.220216070495425,
+	29.312027955730873, 30.374469852146714, 31.436140411946077, 32.50230265905897, 33.56188127097106, 34.58232417499032, 35.62626498604022, 36.63459583619247,
+	37.66446278133203, 38.66001103580565, 39.67754075049024, 40.66041929957739, 41.638703647250125, 42.63803317082172, 43.60413710979496, 44.56541522799392,
+	45.52174926006553, 46.4757923639885, 47.44239548939962, 48.38627522450721, 49.3223785333608, 50.253867071423386, 51.15569823206401, 52.07795787136906,
+	52.99571703749907, 53.90910050234238, 54.81738829301859, 55.72325274406669, 56.60047090701224, 57.496625890386895, 58.3889896065964, 49.800024109964504,
+	39.32214199101489, 28.32126027425954, 16.315950841977003, 0.0, 0.0, 10.826375421216085, 13.445856049097605, 15.218111231286143,
+	16.602274452470798, 17.82568085284627, 19.046964237955518, 20.23426536910805, 21.40974774031304, 22.571626822252867, 23.727835710232767, 24.85703694594034,
+	25.981285274589744, 27.09132875059221, 28.191074692559148, 29.26780971037292, 30.345808661312795, 31.41603881688344, 32.477584553281034, 33.51731394220748,
+	34.56158767179289, 35.58646228527049, 36.61492628335658, 37.623893513183056, 38.62883348575922, 39.63125036752227, 40.62376781751021, 41.607240187277036,
+	42.59425883288003, 43.56418797125167, 44.52839952810329, 45.48851046102033, 46.43970775301804, 47.386930370810646, 48.32866656881617, 49.265043406013845,
+	50.19620165962756, 51.122291298689426, 52.034340011372926, 52.94980016812455, 53.860570738097366, 54.76682365940423, 55.65993762369582, 56.55623568205307,
+	57.448465106378315, 54.23953990541496, 45.068118593384035, 35.50290330298781, 25.8566126958134, 15.260732611284745, 0.0, 0.0,
+	10.826375421216085, 13.445856049097605, 15.218111231286143, 16.597497951694724, 17.82568085284627, 19.039773500077377, 20.23933501973956, 21.422201410395832,
+	22.571626822252867, 23.719431958876523, 24.85344346853627, 25.974353313711422, 27.083060455146683, 28.188681059288903, 29.271678410523837, 30.345057363927925,
+	31.4193452499564, 32.470533822701064, 33.51493193242776, 34.557595608317925, 35.58582724904462, 36.60899017678165, 37.62693884132916, 38.62823877946674,
+	39.62921609905144, 40.62322577062306, 41.61017739055322, 42.59007174440196, 43.562977744833695, 44.52901456496899, 45.48833779028829, 46.44112868628968,
+	47.38758588781715, 48.327918975032084, 49.262343522419215, 50.191077300128086, 51.114337378229685, 52.037939047236954, 52.950867960090584, 53.85736392867053,
+	54.75916059512115, 55.661768367324754, 56.55287036149751, 57.44384944668782, 49.66668554366252, 41.2347817797803, 32.39773327370252, 23.324790377347075,
+	13.85436757248489, 0.0, 0.0, 10.826375421216085, 13.491572900831299, 15.251188370622065, 16.597497951694724, 17.843467240413727,
+	19.071642887661643, 20.23933501973956, 21.422201410395832, 22.587922316650044, 23.742629275664672, 24.87036341456417, 25.989257781186932, 27.12695017010725,
+	28.2154622137476, 29.29288138984621, 30.360465155873158, 31.443930076479372, 32.49024483832369, 33.550015580333735, 34.574354055053334, 35.61514236276517,
+	36.64760868106209, 37.671416684561486, 38.65704832935455, 39.66201083481025, 40.658801932135475, 41.647508620857145, 42.62828219193946, 43.59621609048766,
+	44.566849249026255, 45.52512017373225, 46.47639379299306, 47.42093767176031, 48.3655532038757, 49.3142828985005, 50.238507710489, 51.15697567377726,
+	52.06994957978121, 52.99890208597917, 53.89947808449637, 54.81699741075129, 55.705860350135765, 56.611931202685255, 53.37686760387716, 45.8902109139649,
+	38.023516192136206, 29.843217942653386, 21.575192700697805, 12.819098074678097, 0.0, 0.0, 10.895708411697901, 13.491572900831299,
+	15.251188370622065, 16.642757814728643, 17.875863672067638, 19.071642887661643, 20.27990685291798, 21.466068569356477, 22.632660047391955, 23.780437800937996,
+	24.910989931262424, 26.025960741612113, 27.17898820772474, 28.260821512103412, 29.331370501717853, 30.439598617640293, 31.48355418028863, 32.56589625259595,
+	33.607732779725204, 34.64525650056694, 35.69304649220419, 36.73058071612908, 37.709406254387126, 38.72572981942146, 39.73264950221618, 40.73035497317455,
+	41.71909420789319, 42.69915434039152, 43.67084733034198, 44.634499257368326, 45.590442356030756, 46.5613064623599, 47.52011627698957, 48.45287778678178,
+	49.37913809265502, 50.338249616852146, 51.2499967684515, 52.1561251525154, 53.09368939107159, 53.98673305334272, 54.91118619994228, 55.79204161606901,
+	56.694465196555896, 49.69363080236688, 42.79248294573608, 35.4803676406352, 27.947977789544915, 20.188489510111385, 12.298742759061536, 0.0,
+	0.0, 10.895708411697901, 13.491572900831299, 15.36174992786889, 16.73759732516748, 17.91473140751497, 19.142363083864073, 20.35396292550865,
+	21.54110225670236, 22.699801330877158, 23.849600846843657, 24.97516699776054, 26.08433819319719, 27.25089165478427, 28.32463133664935, 29.459774470299973,
+	30.503143971519936, 31.60755726284462, 32.6257257891441, 33.70165596105074, 34.765595182970344, 35.74948857390616, 36.78856718660491, 37.81664661548005,
+	38.83396187116864, 39.840823958071844, 40.83759355013079, 41.824661680117885, 42.80243560784524, 43.771328525440985, 44.73175211382232, 45.7414925425105,
+	46.68381146379627, 47.61874906635852, 48.60288998246099, 49.52138289790752, 50.43356977264543, 51.39295763560684, 52.29047380810332, 53.23482244284555,
+	54.11877066393922, 55.048534510953345, 55.97184480487067, 53.161824813563264, 46.73585079165169, 39.90502395808852, 33.2424425225755, 26.39309982922825,
+	18.886910197211545, 11.234541856535682, 0.0, 0.0, 10.895708411697901, 13.608780075470696, 15.36174992786889, 16.73759732516748,
+	18.010186481511536, 19.25135164915566, 20.462739809767932, 21.64684206337881, 22.806407008868508, 23.9441530195137, 25.062633468174745, 26.26519651415591,
+	27.342427122541665, 28.40670723194702, 29.54935513814234, 30.582488736386793, 31.69167159841754, 32.78679360689999, 33.77827430374098, 34.844134078181824,
+	35.89693066165791, 36.93714085300761, 37.97701961499875, 38.98123283993875, 39.98605905611322, 40.98007908464099, 41.9565521190588, 42.93775755717571,
+	43.97932610530812, 44.932756110890864, 45.87713823771398, 46.81387554405936, 47.81482496614375, 48.733697262873314, 49.716898677131, 50.619262729284586,
+	51.58518635937957, 52.47239525438499, 53.421703560714036, 54.36353696392462, 55.22851562654807, 56.14080606009661, 50.192122349051566, 43.93496459320824,
+	37.64162520608723, 31.19532799051352, 24.808253784157927, 17.978533765681362, 10.733653381056145, 0.0, 0.0, 11.066410135193472,
+	13.608780075470696, 15.51875227867929, 16.88086511078335, 18.010186481511536, 19.25135164915566, 20.462739809767932, 21.64684206337881, 22.93448044694549,
+	24.063646938689285, 25.173026406247708, 26.387013482881716, 27.42955177320101, 28.62464604022781, 29.66551548213449, 30.74499196659916, 31.790297698802604,
+	32.887717636397866, 33.9698350548903, 35.03699597857321, 36.06408460232643, 37.1284744888722, 38.153981663739344, 39.16684809938734, 40.16771894912845,
+	41.1572311742245, 42.13600526106507, 43.199736687360016, 44.128610987963626, 45.102175396160945, 46.13203574501849, 47.058371991151084, 47.998623429564645,
+	48.975309461596225, 49.876347994422126, 50.85538284735756, 51.82579139735662, 52.70108741912469, 53.65355222914224, 54.597704140653704, 55.450449160292884,
+	53.21676596017497, 47.509606084960595, 41.79004587669596, 35.85914449119971, 29.793661910559926, 23.464832623410018, 17.06722773085963, 10.213555757492443,
+	0.0, 0.0, 11.066410135193472, 13.795018397951372, 15.51875227867929, 16.88086511078335, 18.157463503565108, 19.397668586795575,
+	20.604851156739866, 21.782623536332537, 22.93448044694549, 24.20751637825164, 25.30588633770917, 26.46522899995351, 27.58313455242907, 28.7598745307831,
+	29.77810017671194, 30.91325867441558, 32.030212505312086, 33.12310960094025, 34.211328229147796, 35.15113258209286, 36.202707264921386, 37.23985789675153,
+	38.38236745593992, 39.39378703016206, 40.38501638977111, 41.38767396859644, 42.34060517402634, 43.41282803416638, 44.3610340570996, 45.409371416527485,
+	46.33553980157768, 47.25365818286229, 48.26694732168062, 49.27022075655043, 50.158274589413466, 51.1407892535832, 52.11122395196922, 52.97555780100397,
+	53.92431128488845, 54.865496628815016, 55.78554283779014, 50.66070178210617, 45.36832835812398, 39.7367068505617, 34.010486526210315, 28.330697061794805,
+	22.389442221757655, 16.155927214944445, 9.768672876644676, 0.0, 0.0, 11.066410135193472, 13.795018397951372, 15.729561608016718,
+	17.070765577804398, 18.345469096893254, 19.53407435558925, 20.77925411804035, 21.935930426038492, 23.089142066719845, 24.375086168532448, 25.460664225344956,
+	26.691198630019183, 27.731524913458678, 28.91210693937958, 30.070907428038424, 31.053564287192508, 32.17099463015775, 33.26963164717941, 34.350286002105506,
+	35.41384128759479, 36.46121026428773, 37.52019254777285, 38.51102346414046, 39.649487169920725, 40.6370246809841, 41.61255367583482, 42.70657388840249,
+	43.65582952393742, 44.59525254976907, 45.64833532147309, 46.565240232223296, 47.59371911821143, 48.61090851743479, 49.495676869399105, 50.504496172462225,
+	51.47413058354454, 52.44755063117402, 53.29463809491267, 54.24818209578421, 55.192078340876684, 53.511902035282006, 48.39416350992609, 43.21744577310489,
+	37.865497951938266, 32.42607878281311, 26.940354551005264, 21.170856889290096, 15.248591998956432, 9.18409405886426, 0.0, 0.0,
+	11.332240334401687, 13.795018397951372, 15.729561608016718, 17.070765577804398, 18.345469096893254, 19.634244105829815, 20.984689268548603, 22.140729616075603,
+	23.269561527643372, 24.565584048500057, 25.657016181886323, 26.87247625416037, 27.89799340432206, 29.08091319877546, 30.24042737279695, 31.377363893331058,
+	32.49275195861492, 33.58772126740117, 34.66343774491663, 35.7210629083864, 36.76172865613663, 37.786522162694894, 38.79647740679024, 39.94432123552677,
+	40.93081101082564, 41.88893552857109, 42.99960515695329, 43.927922577805234, 44.998732128077656, 45.91382130502749, 46.957498553339306, 47.98854501353195,
+	48.92093981031174, 49.87583447932917, 50.87058254379019, 51.85386741827507, 52.82596261727472, 53.657001548484665, 54.60859650863475, 55.5500420951382,
+	51.38506489098568, 46.461884041789226, 41.60254398373638, 36.42951920781016, 31.135400538868954, 25.828989513082014, 20.35945662971338, 14.75231976833365,
+	8.679090649848657, 0.0, 0.0, 11.332240334401687, 14.092187440012365, 15.99091432764735, 17.304945291135628, 18.572287148885728,
+	19.79693081068921, 20.984689268548603, 22.360865412860637, 23.474790864215613, 24.75693427489866, 25.833381635899848, 27.072388619680776, 28.28304102609749,
+	29.26582578252598, 30.424810055953284, 31.560133664050532, 32.673077925841724, 33.76496511842285, 34.83710966759345, 35.91888015577051, 37.102580074081374,
+	38.11799269079787, 39.118303279793764, 40.27315584729078, 41.2408563155642, 42.19643198813108, 43.30005239116825, 44.388633065492655, 45.301281460420235,
+	46.36009297501922, 47.24977926694298, 48.281089108463405, 49.29927086809327, 50.30463776711418, 51.29752835132891, 52.276303088831156, 53.24929990039077,
+	54.204931365188656, 55.15153215352595, 54.009641202910366, 49.382289831942856, 44.71743625137457, 39.90568865423813, 35.055172250083494, 30.03017045089505,
+	24.734776580031966, 19.551978373679173, 14.35059192884178, 8.679090649848657, 0.0, 0.0, 11.332240334401687, 14.092187440012365,
+	15.99091432764735, 17.580605156502067, 18.835685138674616, 20.046533124549725, 21.219715037941132, 22.60671545840395, 23.703783095935268, 25.01187026569415,
+	26.04985650566599, 27.290259909066272, 28.500460697932308, 29.6819411805827, 30.83636157039063, 31.965436606672164, 33.07086318489481, 34.15427904047807,
+	35.21724043796098, 36.26121150264333, 37.346171889155734, 38.48606395990874, 39.47501309062384, 40.549166988820566, 41.59026409059083, 42.713517565137586,
+	43.63973205417854, 44.74229692399827, 45.80320537031599, 46.68842803270552, 47.73245229109706, 48.76210641208357, 49.77780926158824, 50.75863254203265,
+	51.76910515494692, 52.74558223782608, 53.70986554712612, 54.662387678494774, 55.6035721369126, 52.104443966160446, 47.666687645462034, 43.052011943836234,
+	38.39423782844825, 33.8792694783723, 28.845780606247335, 24.006999543380722, 18.7518379744152, 13.474835726796085, 8.184646182980186, 0.0,
+	0.0, 11.683504157622815, 14.357223895753055, 16.298949871156367, 17.580605156502067, 18.835685138674616, 20.326883194694982, 21.48274543315704,
+	22.60671545840395, 23.955411843360153, 25.265752293987536, 26.285341190504685, 27.525376687732074, 28.73365220051154, 29.869254561404134, 31.08846777966812,
+	32.21912636847607, 33.26518719683142, 34.3656820099708, 35.63908819808363, 36.671141848324986, 37.685420224844115, 38.81210001560518, 39.86501833803592,
+	41.02718833649513, 41.969585942552975, 43.0936931004348, 44.19074182711769, 45.09586075946136, 46.16849472735135, 47.22496182956547, 48.26578070597757,
+	49.291483270863814, 50.30260535268017, 51.29967986389705, 52.2832318680833, 53.25377507227542, 54.21180939114108, 55.15781931713544, 54.558761892190105,
+	50.3363065840589, 46.042449789500985, 41.73926766624355, 37.101855225624625, 32.70368038167222, 27.86124424416769, 23.2829690127116, 18.318878866679093,
+	13.03778632250237, 7.878234226658639, 0.0, 0.0, 11.683504157622815, 14.357223895753055, 16.298949871156367, 17.899291149170168,
+	19.133196167941698, 20.326883194694982, 21.809602221483843, 22.87690331565061, 24.209635423320517, 25.538778817544824, 26.538982876034417, 27.77699614565303,
+	29.05079481683779, 30.156147234025486, 31.30175693210102, 32.420921117846135, 33.754670674498946, 34.820240193351964, 35.86487579262251, 37.11833573895523,
+	38.11879074500885, 39.324868667636764, 40.28665453390135, 41.44938444434893, 42.37743498850304, 43.50074357575457, 44.60518145281973, 45.69136734673294,
+	46.75994465183596, 47.610664382615994, 48.64805667301782, 49.669948292593766, 50.67693950294343, 51.86136658899152, 52.81632064420772, 53.80084822095898,
+	54.75124420258648, 55.6894508272982, 52.857932488240905, 48.80072537610735, 44.654505140378724, 40.37981703860028, 36.05182430823816, 31.522113242637953,
+	27.207085209161733, 22.565152427624692, 17.57493686084169, 12.614484372081266, 7.6417403284868115, 0.0, 0.0, 11.683504157622815,
+	14.721853334279734, 16.649422092890298, 17.899291149170168, 19.4622017500824, 20.635886429052974, 22.085985755230723, 23.1699886120655, 24.52179466141738,
+	25.829901929376522, 27.097192177648, 28.17121033698249, 29.24473863852683, 30.683849507152775, 31.81695523274032, 32.92301915932123, 34.00425274659456,
+	35.3147388727323, 36.34456875072329, 37.4646489516616, 38.58558847060317, 39.79185600363836, 40.7382050348091, 41.89961791755122, 43.0395670494677,
+	44.033962635070644, 45.03474390814249, 46.186741925069626, 47.18175818010791, 48.22887282840714, 49.259362288260284, 50.27392879364712, 51.27325052894357,
+	52.46266444284017, 53.43039415983608, 54.384719573774355, 55.32622325020477, 55.28354391558871, 51.41131196587053, 47.40280238982775, 43.4231571724647,
+	39.21822809805361, 34.992761991711674, 30.636828442682013, 26.233371272305494, 21.85646219867094, 17.191554376640234, 12.614484372081266, 7.245844610352266,
+	0.0, 0.0, 12.108474271811927, 14.721853334279734, 16.649422092890298, 18.243694341792047, 19.4622017500824, 20.971377192530955,
+	22.085985755230723, 23.484492934992716, 24.83407390887176, 26.138060026866224, 27.362309885830143, 28.62316777534483, 29.810946134873117, 30.966283683752813,
+	32.091932010638445, 33.19040213144913, 34.53343808158974, 35.57605388227268, 36.85957104762323, 37.85391063123339, 39.0894016732841, 40.28800637775839,
+	41.21792352214223, 42.37629435285338, 43.5124386531153, 44.62728549194356, 45.72175586160896, 46.7967509973382, 47.853144847940825, 48.8917794476974,
+	49.91346231367785, 50.91234426938132, 51.90902413318431, 53.101149519749875, 54.05893932232477, 55.095485422333354, 56.144738076836326, 53.75677497792701,
+	49.92784941568541, 46.06361942586954, 42.20313875446336, 38.038296597755355, 33.94302738936453, 29.75689728654441, 25.657455241044318, 21.160327599588893,
+	16.794493850765658, 12.201135315291321, 7.245844610352266, 0.0, 0.0, 12.108474271811927, 15.133683385739772, 17.037902733654388,
+	18.624420347395958, 19.850213213893213, 21.33116748459623, 22.42264419166481, 23.81892358891052, 25.16407464910677, 26.46218962044642, 27.717264644434476,
+	28.933054654236454, 30.113019130828935, 31.26031249554204, 32.674024652742425, 33.75552336957332, 35.09925192090528, 36.12498269312044, 37.40725738605854,
+	38.38404380050327, 39.6109653661649, 40.81138945015789, 41.98647844805174, 42.87781411828021, 44.02133032435441, 45.117959642641274, 46.20641550063502,
+	47.27511290134117, 48.56779777066101, 49.596124558981856, 50.629517888831415, 51.6022534285648, 52.81360264795344, 53.81461627810125, 54.72110739733956,
+	55.87831884690932, 56.04941725932666, 52.39633322449869, 48.67807262836558, 45.00283147735253, 41.16177396132324, 37.079111393673394, 33.13676588034269,
+	29.188059594298842, 24.960004974617842, 20.818200293626205, 16.443160594367573, 11.870310529725737, 7.245844610352266, 0.0, 0.0,
+	12.108474271811927, 15.133683385739772, 17.037902733654388, 18.624420347395958, 20.20392280145303, 21.68794956461182, 22.780270907853513, 24.229188949572293,
+	25.51055153978464, 26.801235796085866, 28.048232866830528, 29.255554875503552, 30.751722893799204, 31.84452849034708, 33.045306515233534, 34.35805640445489,
+	35.3961173615729, 36.70655257375726, 37.86797605520857, 38.94323413852744, 40.16529664344459, 41.360092569397324, 42.52896202955655, 43.67320370226587,
+	44.794063845724615, 45.89273053731889, 46.97033132117292, 48.027933056450394, 49.06654316026195, 50.338677794518226, 51.337988361265786, 52.321009849246046,
+	53.531346162650436, 54.47999068536894, 55.65241862552118, 56.66568389674942, 54.7231351474525, 51.19128766717883, 47.633708313315516, 43.915481509291844,
+	40.105507652558835, 36.37372216499155, 32.349023805699005, 28.316137855809142, 24.35985277011784, 20.148646064695765, 16.080132952590766, 11.412955635097308,
+	6.814320841314497, 0.0, 0.0, 12.42209150722959, 15.586238280973522, 17.550813262521014, 19.0334369721864, 20.61129632357404,
+	21.885108674953383, 23.157098383575054, 24.541644811237315, 25.872280629871746, 27.16077906944364, 28.391966681107693, 29.935340344711797, 31.012153470207124,
+	32.20607499500916, 33.619765451617965, 34.67222288844281, 36.01245460475388, 37.31790925402064, 38.590335672175, 39.529173460985525, 40.733463694650084,
+	41.932238731019055, 43.09354498343799, 44.22993107278467, 45.34272584967483, 46.71011037582857, 47.77922914796153, 48.81977943514754, 49.90004033462738,
+	51.11627610225542, 52.10008990402525, 53.32981063034134, 54.27996880195726, 55.48573349775777, 56.384361212144434, 56.93304767339941, 53.53528786652649,
+	50.08536720992564, 46.53575854935002, 42.98480594871289, 39.21459057903103, 35.427244541650865, 31.571858988294917, 27.69618727995607, 23.733927957731446,
+	19.82248016575102, 15.568516588981526, 11.412955635097308, 6.814320841314497, 0.0, 0.0, 12.67728755801805, 15.586238280973522,
+	17.911292449019374, 19.46748050599621, 21.03958261435146, 22.11502762488213, 23.551406843245825, 24.927052417173645, 26.248069738470242, 27.51995380620747,
+	29.11428137415563, 30.249759672319218, 31.78634001504252, 32.88391116763018, 34.29282078085888, 35.324163285734585, 36.65811307475849, 37.95628981699283,
+	39.22072112370577, 40.45334833316641, 41.65600990351748, 42.83043501668729, 43.97820808947341, 45.10094765986357, 46.19995999894216, 47.276596598111325,
+	48.61642505418774, 49.69763503131231, 50.93686658918024, 51.97214434889048, 53.169532721185504, 54.12082818950658, 55.32193046272914, 56.23904233246579,
+	57.40083479082613, 55.776318669313675, 52.36859547973774, 49.023193332444826, 45.561081075518, 42.04279477749709, 38.407219326649916, 34.779384986900084,
+	31.062052386792192, 27.203514984673152, 23.437691394406123, 19.184735428230898, 15.382144203317786, 11.04325509678162, 6.469513029399967, 0.0,
+	0.0, 13.130513653033015, 16.073308044932684, 17.911292449019374, 19.923449514451896, 21.03958261435146, 22.534950496100027, 23.961541499702612,
+	25.32664690285117, 27.037266030143083, 28.286253101828052, 29.491202280502, 31.0310013486649, 32.14911632757312, 33.59449849937032, 34.64068151018754,
+	36.00477211341437, 37.25910588194315, 38.53144396786237, 39.83183810835884, 41.0965213710702, 42.28908969139133, 43.45333688959534, 44.59094164680344,
+	46.01097860582664, 47.09431533319901, 48.155647355623174, 49.41873886505751, 50.50625846274785, 51.79061589274022, 52.76447006180211, 54.066521246482985,
+	55.216597816534296, 56.13319782359214, 57.30650087913551, 57.87841699738463, 54.654878849173635, 51.37988364350659, 48.05775997039791, 44.700129506280426,
+	41.097913231716326, 37.56662524878036, 34.03482354254197, 30.31391481039085, 26.69887408572926, 22.860310078697513, 18.886985105321195, 15.049800090976191,
+	10.693796268165551, 6.469513029399967, 0.0, 0.0, 13.130513653033015, 16.589136887063503, 18.38784419555659, 20.33238844166705,
+	21.486363973819472, 22.970925573797214, 24.401638563734075, 26.163220330681995, 27.448512918221184, 28.684910123688653, 30.272649357884216, 31.413715647076028,
+	32.89798933771133, 33.960784535530586, 35.35681240241508, 36.710840905302334, 38.025609065691576, 39.3036716943913, 40.54739672931736, 41.758971446449806,
+	42.99669978699786, 44.36048247096418, 45.540569091862544, 46.636534133750516, 48.021558101766445, 49.06643071020574, 50.395013188008576, 51.69593490164211,
+	52.671260178737974, 53.922968717603794, 55.15026927698305, 56.17554198381052, 57.25036413007113, 58.412999479732605, 56.81971134147174, 53.70299279523323,
+	50.43205897083654, 47.17719899602079, 43.896389335104665, 40.41126486676498, 36.93097749449486, 33.35970287414848, 29.828875147586235, 26.146283274618398,
+	22.307815980532457, 18.59214715607329, 14.73048464914379, 10.693796268165551, 6.286157230847557, 0.0, 0.0, 13.704895999981836,
+	16.589136887063503, 18.885856189105457, 20.574399457208923, 21.949374446349722, 23.421134932117326, 25.271569629530326, 26.532140711321414, 27.86950687806522,
+	29.37918714688646, 30.67570690353427, 32.201548473465856, 33.67432195108941, 34.71292107969828, 36.097323632702484, 37.439362928836395, 38.74196035267505,
+	40.00779583604614, 41.59154236167492, 42.78464465373097, 43.94784782336375, 45.08304431828253, 46.522658794132205, 47.60088352197386, 48.97772865409646,
+	50.323874599981515, 51.430472270649005, 52.61726040467102, 53.882418371899426, 55.121897499588975, 56.03685999815879, 57.23147657625633, 58.439230597025066,
+	58.92500375432111, 55.86517047275557, 52.82763137518281, 49.687617318776965, 46.443141872478115, 43.06095194409375, 39.78995849275381, 36.33678238037929,
+	32.92061759229253, 29.35714715581385, 25.651876609076467, 22.041754336984667, 18.32383683932379, 14.48400185000932, 10.333698073735873, 6.072821803054975,
+	0.0, 0.0, 13.704895999981836, 17.163224825668927, 19.40190733831939, 20.889790464532265, 22.57057556894686, 23.88388244530685,
+	25.730126424388402, 27.04169531617325, 28.737013371051255, 29.932692041905117, 31.503082003496253, 33.01536761851061, 34.07150995336463, 35.48813914164052,
+	36.85903875885028, 38.18754405466627, 39.850210774165475, 41.09574756964987, 42.30731113435175, 43.564151768047545, 45.0503994901612, 46.103048827927445,
+	47.53278946664522, 48.92833796489738, 49.95915425052327, 51.264698999716, 52.6010759161841, 53.8790687537479, 55.13015156139715, 56.35553374629363,
+	57.4913143421513, 58.73368699339084, 59.88854114950055, 58.034551847354585, 55.009879457929664, 51.98974028392228, 48.79447490203144, 45.7056496549922,
+	42.39955268733184, 39.06196973681956, 35.74378626559238, 32.28241093092255, 28.900906713115702, 25.40297500744018, 21.782677258057003, 18.03324471404536,
+	14.137350009400805, 10.333698073735873, 6.072821803054975, 0.0, 0.0, 14.308611940727854, 17.686881542024466, 19.9329236775125,
+	21.395047074424948, 22.915845190779898, 24.84703509498804, 26.197527317393273, 27.95354076772964, 29.11695940060049, 30.800086997387563, 32.2099367040582,
+	33.43067258923942, 34.881583539635656, 36.28310454670967, 38.03505352112058, 39.341095337941724, 40.608245525138614, 41.8392812008937, 43.405996257440506,
+	44.565042615648665, 46.053041084912444, 47.14906855423403, 48.56691595381193, 49.95038517474695, 51.30120174627957, 52.62098440028832, 53.9112488873916,
+	55.09160478489847, 56.40880389850804, 57.61866164383216, 58.804148011856086, 59.96635105714404, 60.051705498199524, 57.16920552722497, 54.22727456400474,
+	51.22268216131106, 48.14852184180867, 44.972798657751476, 41.73718003248365, 38.5480895101949, 35.181356642993975, 31.87303704945045, 28.462480025585776,
+	24.936947692004164, 21.292264529519684, 17.771091679045327, 13.866955610800703, 10.069462413869621, 6.072821803054975, 0.0, 0.0,
+	14.308611940727854, 17.686881542024466, 20.321898180471283, 21.91200736217795, 23.924203446935568, 25.332262447640847, 26.660564029469374, 28.419623490434972,
+	30.08957804828262, 31.689606433161973, 32.7881330700666, 34.27562183378926, 36.129591412825086, 37.50603617224265, 38.837562910304804, 40.12760999055616,
+	41.640757742759384, 43.03051791471313, 44.529882997503535, 45.69162191344765, 47.14794234346525, 48.621503275625244, 49.9323886255143, 51.34208762025419,
+	52.32889228507111, 53.63550839529755, 55.10437560047528, 56.49476106912906, 57.713274184464424, 58.906561359450066, 60.0757852777091, 61.571482473282806,
+	59.26122701073686, 56.39933035488519, 53.48361846103139, 50.55032473811152, 47.519207464123774, 44.39610557570238, 41.27553579397449, 38.01647333961965,
+	34.8025935164283, 31.478661422650774, 28.044335078997097, 24.498536497459238, 21.073754646260983, 17.521803581886047, 13.866955610800703, 10.069462413869621,
+	6.072821803054975, 0.0, 0.0, 14.933851633862416, 18.26019008637859, 21.029241872121958, 22.43869026016516, 24.440183518989333,
+	25.830680115825512, 27.642054010456413, 29.204037119884024, 30.55116268709062, 32.155320905825924, 33.667835460261415, 35.572348712560505, 36.97910559832903,
+	38.33776352926887, 39.75190406308462, 41.32845539943544, 42.5560684495604, 44.137879164701395, 45.67410261837009, 47.167497298473265, 48.24820750623977,
+	49.66867983431704, 51.05320932804128, 52.40376304586363, 53.72215523734626, 55.35704137633754, 56.61129883988939, 57.838172552526935, 59.373251423557534,
+	60.54484619336611, 61.6928755503866, 61.28254705446589, 58.50623303992533, 55.70168599944246, 52.80909459564238, 49.90354126836517, 46.86788900139098,
+	43.833770180180515, 40.66379288079706, 37.52256797284717, 34.32136153614475, 31.10120960394547, 27.649066934459572, 24.290816054934417, 20.839530201274073,
+	17.217184138322487, 13.616397918412506, 9.802108853053992, 5.786483589075618, 0.0, 0.0, 15.574209882833731, 18.845001112406806,
+	21.590022213946586, 23.31695897750664, 24.962250349459904, 26.844188799077866, 28.631090435095427, 29.848829821030098, 31.486609108738477, 33.0553583399126,
+	35.01265446678703, 36.45186500564414, 37.83100248630832, 39.60420635559397, 40.892215372020935, 42.548114896228704, 44.15459074074652, 45.31597787147011,
+	46.83436978742334, 48.31034764422212, 49.74647747454564, 51.145100958977984, 52.5083590328499, 53.83821324657946, 55.49272544386021, 56.75604087803234,
+	58.33838446293902, 59.5418322312253, 61.058611876843436, 62.207912854434454, 63.24612859070125, 60.595554018133676, 57.87358559091393, 55.129721471035545,
+	52.22414719418393, 49.318164575343985, 46.371926747777664, 43.291791000478874, 40.26865956398198, 37.053269280580125, 33.93971930573399, 30.742693149044815,
+	27.279123630543722, 23.908528021900807, 20.629223865046562, 17.065963752261236, 13.364492252136174, 9.802108853053992, 5.786483589075618, 0.0,
+	0.0, 15.574209882833731, 19.438367404014524, 22.15667319584033, 24.06037055529177, 26.020113865957505, 27.4481069814851, 29.131046469986494,
+	30.820588423341572, 32.434797561026635, 34.26273395410427, 35.921499656239554, 37.489365968176564, 39.145582052077344, 40.45813321567741, 42.148717645062405,
+	43.784543726367524, 45.36944797301471, 46.8399473478959, 48.399940719517, 49.85143904182647, 51.26391331065863, 52.63967088493507, 54.35101224064097,
+	55.65405284557298, 57.08363027959753, 58.524633925470205, 60.08603642725076, 61.265693624951005, 62.76259840325005, 64.16450610516343, 62.59965004770775,
+	59.986714763719206, 57.25654730418126, 54.54476045123295, 51.73984517982534, 48.77562467225308, 45.89283330361087, 42.90259907192195, 39.80800940287569,
+	36.75621706531122, 33.55878495632883, 30.40519646936927, 27.10498714705255, 23.719199981184282, 20.275770951956947, 16.86150460589886, 13.364492252136174,
+	9.60147615600371, 5.786483589075618, 0.0, 0.0, 16.116127158124463, 20.167860239757367, 22.727598461421387, 24.610163119642326,
+	26.553944923266272, 28.39261494304665, 30.138573473877273, 31.885882100377344, 33.861266279347156, 35.384707399148866, 36.990195558885056, 38.68467401784687,
+	40.46429437227519, 41.7491270076418, 43.41647663827243, 45.03001188090721, 46.59354524534116, 48.11048391753821, 49.939515396992306, 51.49129371249892,
+	52.86256560498803, 54.52492950062468, 55.8390539186025, 57.48681047402589, 59.09556693653887, 60.29322962598651, 61.847471832613394, 63.35211587671642,
+	64.8238839640492, 64.54938961579116, 62.027688344753166, 59.421819183039176, 56.75941683837625, 54.0438818405519, 51.17297292680059, 48.357795517403446,
+	45.45847353101533, 42.412396747141024, 39.41565490334159, 36.353507959207235, 33.17988321581809, 30.089423807706133, 26.781542400930142, 23.54742244354965,
+	20.070662426904306, 16.67406840828564, 13.182069704446924, 9.496808340448752, 5.786483589075618, 0.0, 0.0, 16.880623323846507,
+	20.641161250298875, 23.426278734436984, 25.71689556278695, 27.576677582531836, 29.43512693730292, 31.15258908768531, 33.28474300980727, 34.83761131342961,
+	36.798241033936485, 38.21825563270478, 40.03743342839907, 41.79012291956319, 43.48170341466413, 45.11689414064507, 46.60698900581592, 48.234283752240025,
+	50.12752723586392, 51.567779038548025, 53.17344091060507, 54.7178257547438, 56.42070520819305, 57.70574806771885, 59.32898552681009, 60.913014031225714,
+	62.459865555531714, 63.97140730273351, 65.38516745657616, 66.4586188748278, 64.02783440967988, 61.524254542220476, 58.8968622869033, 56.26115571724419,
+	53.54289793371395, 50.81007067542802, 47.95908175124604, 45.00851919048384, 42.0703935241838, 39.07116207450552, 36.078828453325606, 32.90849733281709,
+	29.801856405825443, 26.627864541260305, 23.25565854965693, 19.910824041306523, 16.48527684083483, 13.001836355600553, 9.376248539017984, 5.5732334335805005,
+	0.0, 0.0, 17.5392620479766, 21.85295740865205, 24.453143547326704, 26.8274422366259, 28.70370765359555, 30.48046376899485,
+	32.16939848246206, 34.27564797379196, 36.143330031835816, 37.74257409206441, 39.603263895288464, 41.36176123413333, 43.11852902620322, 44.95222055717982,
+	46.82120953476739, 48.36186940352581, 50.28992439550671, 51.7446012774086, 53.503349053761774, 55.01395287037707, 56.6452302926629, 58.31961920512619,
+	59.95157168216659, 61.54342668568887, 63.09731766009219, 64.61519651755953, 66.45328828531795, 67.89991552347898, 65.96904983475238, 63.55332876559977,
+	61.03000480057166, 58.465493118500994, 55.844859749985595, 53.16505460311753, 50.38126972327162, 47.58723037227546, 44.71048682855258, 41.77796105210052,
+	38.827635455178196, 35.72210295943301, 32.664042245679745, 29.53867847775667, 26.35166041692089, 23.0949644369161, 19.765237979187994, 16.354232190832708,
+	13.001836355600553, 9.376248539017984, 5.5732334335805005, 0.0, 0.0, 18.197842016896555, 22.404102539195094, 25.523415362336273,
+	27.936377860539128, 29.77890325689414, 31.525058484245008, 33.68754109354787, 35.75984585637291, 37.7351629469167, 39.62844505794161, 41.44736639949613,
+	43.19848289659697, 44.88744650786086, 46.95187995064234, 48.52279730102959, 50.483954755971396, 51.93185104862023, 53.76264322792291, 55.541112423883924,
+	57.270584255772874, 58.954045065136825, 60.59418781875623, 62.294454524970995, 64.12162239637834, 65.64064993970884, 67.12508797480838, 68.9297434267675,
+	67.88808141658647, 65.53794538549475, 63.14904023101553, 60.6298539318783, 58.12539159895138, 55.45045046950366, 52.800110474159546, 50.06410102720046,
+	47.24581366096415, 44.42166064475711, 41.46687305061701, 38.52024611676959, 35.5123352235772, 32.43680605167361, 29.308557802452935, 26.179950791116227,
+	22.966624783814503, 19.615673416757897, 16.354232190832708, 12.868025332221006, 9.22476982180184, 5.5732334335805005, 0.0, 0.0,
+	18.854334808677304, 23.66452694946949, 26.74898968038931, 29.03959111875641, 30.84949518872142, 33.08451453066811, 35.209218898038294, 37.23602463484354,
+	39.17518341985039, 41.03527038858973, 42.926933178133964, 44.971429703424725, 46.647524139285764, 48.67626495174536, 50.638184488042995, 52.5383045018697,
+	54.37334138972575, 56.17029370939556, 57.90953954537519, 59.71459762519604, 61.63105303633318, 63.2322197092547, 64.79433295703907, 66.71292380570408,
+	68.52918151819918, 69.97673845625039, 69.7667397069068, 67.51393886577556, 65.17862684162455, 62.77976521727777, 60.29273083972066, 57.79399562033405,
+	55.14772290149897, 52.468735711944106, 49.75748452187144, 47.01392419209912, 44.1158239146853, 41.21234042651548, 38.338220876449185, 35.315238189659865,
+	32.24512065982725, 29.20932865352293, 26.00700776028134, 22.850252171866764, 19.519224606594296, 16.223678664756203, 12.72322367554807, 9.22476982180184,
+	5.5732334335805005, 0.0, 0.0, 20.155088052807862, 24.26312622707328, 27.732395324390183, 30.113310405626102, 32.441223598570154,
+	34.628850177436824, 36.71109403618568, 38.699538943971284, 41.07689622546647, 42.894602040896665, 45.09562259476426, 47.21509325270277, 48.827396454189525,
+	50.81244477587626, 52.73370902335087, 54.88901720369401, 56.80489010140843, 58.6890260786011, 60.64411961286952, 62.27704431194213, 64.28040752124966,
+	66.21513252699653, 67.86143178295912, 69.58414213423455, 71.38835889807326, 71.60421123242587, 69.45381553924179, 67.20549659533448, 64.88275448457779,
+	62.505869141119774, 60.02580439680932, 57.49813534533828, 54.87729589068663, 52.23417205262056, 49.52149974583617, 46.74211193983348, 43.89875168094864,
+	41.06256392397236, 38.099845433486585, 35.15411986729307, 32.07596310622531, 29.021064597049016, 25.91199600673763, 22.65501847252097, 19.41990156199161,
+	16.1249804767985, 12.72322367554807, 9.22476982180184, 5.438658769809223, 0.0, 0.0, 20.797182170554205, 26.034920856495795,
+	29.004615238327588, 31.75421215905806, 34.07926263472693, 36.15306934859454, 38.685093568119804, 40.62545290825608, 42.951776395838706, 45.16858390259457,
+	47.328910379921865, 49.40386625058593, 51.40427702796649, 53.33956154879528, 55.6239991582897, 57.43603446043857, 59.591209002169585, 61.68475055521664,
+	63.339348533912506, 65.32769416527029, 67.26510091331582, 69.15453953576935, 71.32220299224697, 73.1538897692242, 73.41161518718198, 71.34657525987394,
+	69.19747391321296, 66.97541178757965, 64.63946679734327, 62.259598584201235, 59.79109100084317, 57.277509119811775, 54.68899930338467, 52.032321519513026,
+	49.307480273483115, 46.57019404302303, 43.72167371038696, 40.870418315294614, 37.9664943003583, 35.00702154060481, 31.93674771323008, 28.883889572035486,
+	25.753584627632936, 22.576899843982563, 19.342004181736318, 16.025312473642792, 12.627468344184024, 9.118013811056532, 5.438658769809223, 0.0,
+	0.0, 22.061189095948414, 27.19242426354004, 30.657223618584133, 33.34425750820864, 35.656525492820116, 38.14838857162473, 40.54184502120999,
+	42.984608805600665, 45.25112115523621, 47.429872110743304, 49.52883880047721, 51.98014121106244, 53.931024246756024, 56.229010882557034, 58.45499410138269,
+	60.61437123485117, 62.711859680691745, 64.70772268705733, 66.73731183934841, 69.03914444475252, 70.83343432900507, 72.75781954378358, 74.91160368919124,
+	75.19131770035072, 73.22757351295886, 71.15901981619848, 69.01096362888687, 66.77264521182641, 64.45913459872928, 62.08334671686974, 59.62912377859651,
+	57.10065588227494, 54.50052110459955, 51.86855157041398, 49.17690485590909, 46.38779598023507, 43.59642244542239, 40.72044158106816, 37.80777874018489,
+	34.845108390195705, 31.85747058737954, 28.811740431160523, 25.690731910293813, 22.512598858049348, 19.271016173956642, 15.95878968195133, 12.627468344184024,
+	9.118013811056532, 5.438658769809223, 0.0, 0.0, 23.29543000619518, 28.888682006233363, 32.27118528403972, 35.42697670227896,
+	38.04699056843962, 40.57669338154071, 42.98120062036285, 45.38146835506684, 47.93901341769539, 50.0592332196302, 52.43520542987743, 54.90996597228646,
+	57.21396550784399, 59.4457254451618, 61.610651712168526, 64.09506812012665, 66.13372215270246, 68.48695503437328, 70.77770851402377, 72.69538871058158,
+	74.93846444617272, 76.96804223507552, 76.94213321290684, 75.07198205698468, 73.09886300196786, 71.03920412678737, 68.87981858284166, 66.65399279065872,
+	64.34187665008214, 61.95188361109553, 59.50610304217147, 56.9751712317721, 54.387415135517344, 51.74838508786339, 49.047605488550836, 46.29055289559829,
+	43.508670253443626, 40.64559441647318, 37.73022623670504, 34.7663440892628, 31.785655593202478, 28.71859264000818, 25.61502202315081, 22.46129103371651,
+	19.222236279547623, 15.95878968195133, 12.562057055493979, 9.055796827920085, 5.438658769809223, 0.0, 0.0, 24.76141438468802,
+	30.53448406163384, 34.35948839371308, 37.39706573824526, 40.000881926375364, 42.9279325207488, 45.722363739549316, 47.96206912269756, 50.62793186925357,
+	53.37047740979802, 55.84096032942488, 58.15371962871217, 60.64817487287389, 62.9957152641625, 65.43014445563689, 67.83922055091725, 70.28489535640908,
+	72.52272911632436, 75.03763886805727, 77.20251415721707, 79.64988529201312, 78.66524346637223, 76.8937262155014, 75.01739644606953, 73.04336969027791,
+	70.97530523916518, 68.82129233218544, 66.58298851232192, 64.27027445739489, 61.88618261084744, 59.43039871968123, 56.90845090721929, 54.325563005346474,
+	51.677433607859264, 48.986823422120075, 46.230125712437356, 43.43518225014372, 40.5734992502779, 37.67920015944433, 34.720999215781994, 31.72105956035974,
+	28.664374550170546, 25.57046552447368, 22.42435109765844, 19.190976396000696, 15.914690858050864, 12.526290991412793, 9.055796827920085, 5.403243660921907,
+	0.0, 0.0, 26.241574035659283, 32.64935736673418, 36.866159183230124, 39.845494356604796, 42.80911913160852, 45.64671143033203,
+	48.79307622514786, 51.391354487102674, 54.300997141313744, 56.844432675142386, 59.422757730581125, 62.05045930093193, 64.68457256719633, 67.32953310414612,
+	69.8289996176659, 72.53151024734821, 75.0604939698184, 77.41798182992315, 79.81194385442231, 81.91660600297848, 80.36457814810606, 78.69540722494317,
+	76.91475159648418, 75.0319166724453, 73.05031690193738, 70.97930254445208, 68.81850577967712, 66.57751602803626, 64.26040923605034, 61.868702638306,
+	59.411268238223606, 56.887018609793806, 54.30277059088982, 51.65993886388669, 48.962031268178166, 46.20924424514849, 43.40604874896324, 40.55359132685405,
+	37.65288037139732, 34.69888187733854, 31.70004160737959, 28.652279128993463, 25.553814322865836, 22.394087659033296, 19.180002093439686, 15.892377565239212,
+	12.526290991412793, 9.044704132946963, 5.403243660921907, 0.0, 0.0, 28.58761577376729, 35.168298204359424, 39.73018479731816,
+	43.04780484167501, 46.11134771681972, 49.102157342618455, 52.08349498523535, 55.13278028094345, 58.178851343956566, 60.90928500738122, 63.965589928700886,
+	66.82437648279515, 69.58263622305796, 72.29450483909797, 75.13221579356923, 77.75667362537064, 80.50605305412266, 83.25855914254298, 83.48175861849487,
+	82.0391575828144, 80.47397756674485, 78.79325750615729, 77.00272004061834, 75.11761041630679, 73.12723096076049, 71.04885683038692, 68.87433761174464,
+	66.63299697096706, 64.30654139579902, 61.90742653769865, 59.44445853429513, 56.91926211381688, 54.332115589835375, 51.68619901014085, 48.98432621443687,
+	46.22893054823859, 43.42204314819127, 40.565260745394596, 37.65969858029067, 34.70592176980508, 31.70960176819733, 28.65881006735629, 25.55662948091624,
+	22.399480755784136, 19.181822058815676, 15.894876957058404, 12.52429655039632, 9.044704132946963, 5.403243660921907, 0.0, 0.0,
+	31.056055012978955, 38.000075939324525, 42.773038154811765, 46.431915004029776, 49.89618826198144, 53.08957119834331, 56.41082847626442, 59.700545146757136,
+	62.75708379567677, 66.04014134431536, 69.03845145511289, 72.05582085991259, 75.04025553100469, 78.1482287387776, 81.03953369338433, 83.98004375482039,
+	86.20878832132676, 85.01516766963648, 83.69721504228737, 82.2410848495421, 80.6584284422721, 78.96377617502793, 77.1693991446578, 75.27777840086577,
+	73.26960342846357, 71.17453485328575, 68.99702220151737, 66.74137282428195, 64.41171273021159, 62.01195656798104, 59.54578426953734, 57.01662222046829,
+	54.3990385920371, 51.75206361147989, 49.05048237177564, 46.29651145007504, 43.46370632326143, 40.60869102364425, 37.705356538113186, 34.75418261853933,
+	31.727708019756744, 28.677664903128374, 25.576327339242635, 22.420292387525752, 19.204144292589522, 15.919351144412609, 12.52429655039632, 9.044704132946963,
+	5.403243660921907, 0.0, 0.0, 31.74213386907616, 39.071744694164416, 44.136042453939424, 47.952574551140536, 51.37818184626217,
+	54.76159895578261, 58.041287432781616, 61.34830958114561, 64.57078465915296, 67.79508165377979, 70.9405771890034, 74.04984328038302, 77.11655189538952,
+	80.16706249452628, 83.17808928359388, 86.15918294576944, 86.53044499622966, 85.32430731347193, 83.99048995514269, 82.52059749320286, 80.91056494582203,
+	79.23045184959965, 77.41525671611014, 75.50576204288673, 73.4622506620482, 71.3654264421162, 69.19985590680399, 66.90878988276816, 64.5843916905238,
+	62.14863023683128, 59.687547164750534, 57.13065207799878, 54.53146055890714, 51.893661229326476, 49.15749128584758, 46.40949318646277, 43.56611787646488,
+	40.71751974348162, 37.775771697916035, 34.829936775629704, 31.80133850938744, 28.752708105012264, 25.60832929788593, 22.452861467868363, 19.23732037700586,
+	15.919351144412609, 12.552106829957328, 9.078526686389983, 5.451856486333246, 0.0, 0.0, 31.435409427258694, 38.74505334980945,
+	43.53647256476189, 47.35945153912393, 50.79406706910911, 54.09244153530736, 57.42911312328626, 60.63828716152364, 63.83624225743475, 67.01779857795736,
+	70.1134474327026, 73.20385408146635, 76.2798361544469, 79.27727191052682, 82.24690075080363, 85.187199584766, 86.94400412320476, 85.72276094697824,
+	84.36071119065069, 82.87246967067497, 81.28270750831393, 79.52968973500998, 77.72790981438169, 75.77364207655536, 73.787233656632, 71.65539169973272,
+	69.44007222925487, 67.16016004070137, 64.83313785553864, 62.400167444474825, 59.88622438348211, 57.31570696922029, 54.71734545073169, 52.053438104092656,
+	49.3091125157251, 46.51199686165953, 43.72215271792538, 40.83775417465588, 37.875445981527584, 34.93860128566718, 31.892498672736394, 28.797598097376426,
+	25.709418951574087, 22.505935989171515, 19.30257222047757, 15.984435659176844, 12.592606998953947, 9.078526686389983, 5.451856486333246, 0.0,
+	0.0, 30.974666276728225, 38.241315631851, 43.04369726424188, 46.82642701358894, 50.1745218012628, 53.49605716996835, 56.778779050730535,
+	59.955352975245795, 63.17326501495706, 66.23071003463303, 69.32982295622718, 72.41010331130592, 75.40507589466884, 78.36925540187353, 81.37968085485267,
+	84.25762406591755, 87.18304512461769, 86.224189869884, 84.82831246279342, 83.30940370401056, 81.67558136072059, 79.9302750206794, 78.08410045672488,
+	76.13477069084915, 74.09658452636108, 71.96715433917913, 69.7537121556871, 67.46078260656985, 65.08915255003201, 62.65324165071905, 60.14633003935578,
+	57.57531403855575, 54.959736903621376, 52.25315263165051, 49.507319487281066, 46.71330793259112, 43.8565345503847, 40.95558283071924, 38.080971950504946,
+	35.08063448304576, 32.03091714194393, 28.914934528273452, 25.778528294029005, 22.650124101246657, 19.378457245112628, 16.03645272542365, 12.629286092391037,
+	9.130815231187825, 5.451856486333246, 0.0, 0.0, 30.69386459550758, 37.795474289823396, 42.609769573283955, 46.30347707096747,
+	49.666543509247994, 52.936237763049355, 56.17181830327645, 59.323696263244585, 62.51028565554715, 65.57990014112498, 68.59774586300044, 71.66884751491203,
+	74.64907850418781, 77.56864995610034, 80.49641114352774, 83.38214772990615, 86.2437075815277, 86.79632822250602, 85.37387982242437, 83.89314084338434,
+	82.18972372456231, 80.45789332007583, 78.62117862233096, 76.61562281339098, 74.56536345414897, 72.44407267656652, 70.16913702447768, 67.86199657809809,
+	65.49962256502508, 62.975203415158994, 60.481815750704996, 57.90492148878222, 55.27650413089798, 52.49787886929841, 49.75390240405728, 46.97345626166804,
+	44.10645309582782, 41.206077560655814, 38.25593036857935, 35.223994328841655, 32.20704771236635, 29.106898155389057, 25.953741501858815, 22.75239232866104,
+	19.471527489012622, 16.128433738438304, 12.699019798243144, 9.180765179092456, 5.451856486333246, 0.0, 0.0, 30.430088284956113,
+	37.410090692177974, 42.194433592565495, 45.839663165566236, 49.10486590684166, 52.31559525688488, 55.57439246918903, 58.765577391658795, 61.8748755558038,
+	64.89303088507378, 67.92702579052946, 70.84923184666425, 73.85385936840946, 76.78475797248136, 79.71377646609706, 82.55286476626196, 85.36647999234553,
+	87.44993737850932, 86.03251728822886, 84.463202744548, 82.84296819797365, 81.02338336580769, 79.0963739507434, 77.17210715589722, 75.04675376153008,
+	72.93664465927712, 70.63306434121104, 68.33488559590232, 65.88502003669151, 63.470987538512055, 60.86790367280573, 58.282524304439, 55.577820958550596,
+	52.88263950737731, 50.05045125216822, 47.286085761208795, 44.41403199773466, 41.408064402111975, 38.44798247079279, 35.46594253839571, 32.42092793696886,
+	29.256993380383015, 26.05972000108226, 22.85212899999633, 19.58252766745375, 16.232895767111575, 12.816411815339327, 9.280413653694582, 5.451856486333246,
+	0.0, 0.0, 30.154483469483473, 37.09161894502722, 41.691083185614225, 45.34714432980427, 48.62445002127539, 51.858628532395784,
+	55.034805029329156, 58.16337066442815, 61.21728086568274, 64.24147757204166, 67.29630445275512, 70.20613327212051, 73.18643219661429, 76.02749601156616,
+	78.91737891327524, 81.77212564630901, 84.58301522365305, 87.30419975887416, 86.80458297772691, 85.19705344926228, 83.51256235032804, 81.70952241834524,
+	79.78189986850658, 77.74810113526706, 75.61916042645197, 73.52415515837464, 71.2164937569655, 68.82711363016165, 66.36057279601165, 63.94698716260821,
+	61.333499864017156, 58.688376805045074, 56.05099821605063, 53.234074276014304, 50.398343011103904, 47.585951718696144, 44.65187924723007, 41.77090546687662,
+	38.69810336418203, 35.70944386473143, 32.54198700970709, 29.45073333364074, 26.30794300713175, 22.974429079425377, 19.71012284150673, 16.376779553010838,
+	12.816411815339327, 9.280413653694582, 5.537289159349803, 0.0, 0.0, 29.74911634441274, 36.637123535379445, 41.27273017679518,
+	44.937671577744865, 48.14901664549924, 51.39853258257608, 54.499246600658786, 57.544615758105635, 60.69796213638868, 63.64400509943489, 66.63423056534452,
+	69.58076539080052, 72.48529382942421, 75.34753190479039, 78.17809115393054, 80.97062032419022, 83.79567604732918, 86.53448846719046, 87.60046476986926,
+	86.06023295721005, 84.31254365253737, 82.4862413338128, 80.60076282165913, 78.5678034568304, 76.43716390806588, 74.21423941432394, 71.89455137534593,
+	69.5116735266103, 67.04135757444439, 64.49739933158381, 61.883701930080356, 59.20386060071066, 56.4611597327503, 53.70016777193084, 50.94403072604881,
+	47.99086686276683, 45.05843342346544, 42.037300753468756, 39.11090191777323, 35.95699088996854, 32.81217702977223, 29.732449031185585, 26.449936697541318,
+	23.260587725014624, 19.85428276696452, 16.376779553010838, 12.941586639805998, 9.280413653694582, 5.620436720655657, 0.0, 0.0,
+	29.494588156246408, 36.29293908253257, 40.858785875660764, 44.52981225704405, 47.75873968444546, 50.83226904431282, 54.036883631437696, 57.091959097843606,
+	60.09900762978534, 63.05936327976667, 66.06189315069784, 69.01967329753835, 71.85375946687812, 74.65208473769302, 77.49463549962852, 80.30176894679016,
+	83.08150406015099, 85.75273270515045, 88.518003139427, 86.960634751566, 85.18368812911666, 83.327340350111, 81.39902890660389, 79.36750208773488,
+	77.22562636397053, 74.8450440390883, 72.5346714228682, 70.14821720182329, 67.64090186418191, 65.116110965788, 62.49773638870568, 59.792349154951346,
+	57.08895038676913, 54.278741448223485, 51.41582914317403, 48.444627724219416, 45.52517605313392, 42.500651232132135, 39.42471522961155, 36.2977436880407,
+	33.14624055799495, 29.888797568481284, 26.768931315496772, 23.42395409156339, 20.031652426472824, 16.531874630094332, 13.133140810823592, 9.443378103570414,
+	5.620436720655657, 0.0, 0.0, 29.247357741558318, 36.070868690943946, 40.64474177760716, 44.22579191238929, 47.3705066119656,
+	50.45718750789455, 53.48862648179052, 56.56141931190646, 59.58220606859992, 62.55623205365534, 65.48666817299026, 68.37588152199362, 71.22736261696343,
+	74.04272634910132, 76.89791994113898, 79.57128492241215, 82.3606740387607, 85.04703310165216, 87.84356816799651, 87.99764854304655, 86.2109716723056,
+	84.3755511004328, 82.30716246854374, 80.29089929276726, 78.01211537911054, 75.72619428324482, 73.38263697880473, 71.03730933590373, 68.37813269775071,
+	65.83399493065029, 63.21981123366978, 60.364275699882185, 57.60905884227039, 54.804377488569465, 51.94195233988497, 49.0379005771789, 46.052330779735016,
+	42.84532018441451, 39.84290372707668, 36.64203327248722, 33.463320963155155, 30.23813294325611, 26.94558445119603, 23.649194966832695, 20.19063431403888,
+	16.706622340406984, 13.133140810823592, 9.443378103570414, 5.786300189649159, 0.0, 0.0, 29.001804650105903, 35.62947420365994,
+	40.21943786410265, 43.82599590366227, 46.98498896026871, 50.08469434094196, 53.12833739480476, 56.11914830745891, 59.15157087976371, 62.057505970100316,
+	64.91677217390296, 67.82172851079697, 70.68791434349995, 73.43950536240321, 76.2301134540796, 78.99488128301752, 81.72221381235133, 84.4905057919149,
+	87.0897807478805, 89.2149266471223, 87.36286751961087, 85.47733852829988, 83.34397129079728, 81.31209149254701, 79.04315966213468, 76.75188571488579,
+	74.28070731132537, 71.8438549230601, 69.3314292387277, 66.62554740680964, 64.02253532076847, 61.129375722241406, 58.36137051973743, 55.38255536312295,
+	52.486685823821595, 49.607943122913895, 46.43730947781961, 43.415716444491494, 40.31047893808297, 37.22003679114404, 33.84310838338237, 30.614816565869532,
+	27.332241184296997, 23.9918287394541, 20.38192012599326, 16.905203860050644, 13.329665662137511, 9.609017340277951, 5.786300189649159, 0.0,
+	0.0, 28.757674558477984, 35.41060202090421, 39.912542676899136, 43.43115316563533, 46.499549522054, 49.61571663666947, 52.67751940863223,
+	55.68933005250048, 58.65150965126071, 61.564296936756726, 64.4341925213113, 67.28050758630464, 70.14810977532123, 72.91169727302291, 75.64421217997415,
+	78.42416698244497, 81.09740543422376, 83.80606629218155, 86.41513567112987, 89.07359181650664, 88.71863841367242, 86.73866100474315, 84.74516168457356,
+	82.5121292866556, 80.15076931624002, 77.89329371621304, 75.34692571244169, 72.733163813763, 70.23134787340905, 67.53043774208176, 64.84625519108195,
+	61.994098623370796, 59.25860012320245, 56.23588073091855, 53.18221074891957, 50.24918421333993, 47.064881310011, 44.049622551820185, 40.76331037205759,
+	37.5805718676577, 34.28748446286484, 31.036336825379227, 27.541830735806588, 24.16164631516073, 20.80829304361397, 17.11191314275779, 13.541719192910985,
+	9.877275182953001, 5.786300189649159, 0.0, 0.0, 28.384468575008245, 35.071655052069744, 39.615889412566574, 43.12687076628755,
+	46.13861701831343, 49.262869786386005, 52.32250906940909, 55.27120076186817, 58.23097740461325, 61.07790170658527, 63.97704184886056, 66.75321145412924,
+	69.62807123821588, 72.4032933798561, 75.14727103382917, 77.861506990243, 80.48748091975327, 83.20607108551499, 85.8231827340484, 88.48828786092727,
+	90.1105402656391, 88.20139761946542, 86.07864833561594, 83.80135304568391, 81.37598291725425, 79.16097793718211, 76.60485280707114, 73.99352555973921,
+	71.3195660901938, 68.5119043545408, 65.84440736804416, 62.94879246561486, 59.94983936604578, 57.13079997355152, 54.09192991254902, 50.947485177331636,
+	47.75213335356608, 44.74616276135869, 41.44894962343828, 38.10286653603913, 34.84100696130307, 31.495977658513212, 27.99244261639917, 24.669819823463797,
+	21.042376433514374, 17.340813766673158, 13.791496220984836, 9.877275182953001, 5.786300189649159, 0.0, 0.0, 28.176688393206483,
+	34.8644118876671, 39.29893318858855, 42.765529566866675, 45.85329350470189, 48.91434576170687, 51.89900634974855, 54.853798029044064, 57.764283224604064,
+	60.691058791104744, 63.5998595025908, 66.36633625706773, 69.12206678765848, 71.90796865074702, 74.60351726051104, 77.32930067371852, 80.02523597859047,
+	82.62135803399894, 85.26148848165393, 87.86750772295261, 90.46360310100688, 89.70592136698751, 87.56602143666208, 85.15015954407637, 82.78099031346413,
+	80.28382864004934, 77.72800133274283, 75.23768957172511, 72.59703022896257, 69.79437611250204, 66.90856663448069, 64.01753937437971, 61.0038503424342,
+	57.9569296390907, 54.92729162327399, 51.70242620498832, 48.49837600014488, 45.244784178663224, 42.18088312850791, 38.837625435698286, 35.43520056737595,
+	31.97369452993017, 28.451900761609263, 24.994347938463758, 21.34001572935199, 17.607971424652355, 14.053756082510324, 10.141365031055862, 5.978864534022387,
+	0.0, 0.0, 28.03894226034164, 34.677668743015744, 39.01775897967436, 42.46851021714741, 45.522437068181226, 48.65932158740123,
+	51.58307747812203, 54.538187267605814, 57.3819751987484, 60.25297504332079, 63.08793482139235, 65.87732226945076, 68.71530056098732, 71.42672537611675,
+	74.13541027300577, 76.7938218758251, 79.50002026546986, 82.12403120053409, 84.7100078459797, 87.31869436964875, 89.86073119537468, 91.39475348400835,
+	89.15467091573714, 86.8348930548801, 84.42420186870169, 81.82632458666956, 79.20405950315477, 76.52612851027415, 73.83828437095208, 70.92629543443383,
+	68.03481773881222, 65.16843964480394, 62.12835345485816, 59.120248651154725, 55.95884272283063, 52.796096555457474, 49.58361144705276, 46.043178966675534,
+	42.73540321998135, 39.37952309538459, 35.974966373721664, 32.52031054260553, 29.013022934563864, 25.449050154075053, 21.822134734957295, 18.12259611958388,
+	14.053756082510324, 10.141365031055862, 5.412390331978468, 0.0, 0.0, 27.809633465248712, 34.35560296751517, 38.82294385344706,
+	42.209192474081924, 45.24135736265383, 48.24805658380375, 51.25949438514225, 54.21666649731796, 57.012334180547846, 59.892617700944584, 62.73672846152432,
+	65.53410045618419, 68.29492889451163, 70.98281861241679, 73.68138437777979, 76.37396399227664, 78.96355990761253, 81.62718345488786, 84.24282167936494,
+	86.81165028756998, 89.35745362145595, 91.88210238242937, 91.0538960783519, 88.79080265055875, 86.1278988139841, 83.46986217037288, 80.8485925944208,
+	78.22878513977456, 75.41312030096728, 72.52570119652431, 69.38003510508025, 66.38221172386025, 63.345075466148266, 60.21439534312225, 56.903361772095614,
+	53.68070601244632, 50.46388788291852, 47.199729270169655, 43.589930548693324, 40.23358009541298, 36.82959005017164, 33.0837314020152, 29.57929384543048,
+	25.80415720491127, 22.162331985128105, 18.298332862776803, 14.334939187778248, 10.410326489260889, 5.412390331978468, 0.0, 0.0,
+	27.63528887025061, 34.19136362719549, 38.55915288732562, 41.88862941465751, 44.998643562992754, 47.99309730305125, 50.94333028556631, 53.84322040473762,
+	56.78376656800613, 59.544558536005916, 62.39676198352804, 65.12951912841558, 67.86167559718106, 70.59273366421226, 73.25068469489291, 75.88933144726953,
+	78.5917776712783, 81.16809388601452, 83.78239356271837, 86.29961117488625, 88.85514404526806, 91.39047610439214, 93.15896583925027, 90.68838008065876,
+	88.16728759208476, 85.32745409176795, 82.62932297904132, 79.88874781640308, 77.11639604212476, 73.93113605113507, 70.9235059210624, 67.96277872872655,
+	64.6533900691402, 61.5203375122469, 58.22448574867833, 54.94436003934211, 51.39177451969695, 48.13421657443282, 44.581365834042884, 41.11166643841342,
+	37.436466087788574, 33.74125843157827, 30.090564077860844, 26.320581781518438, 22.707765696040717, 18.715003196671738, 14.640691758775581, 10.747571945590009,
+	4.772526316388009, 0.0, 0.0, 27.49662437401258, 33.9109533123996, 38.38894425307236, 41.720753235048015, 44.70784036118028,
+	47.76648810681135, 50.66491755835261, 53.58021029394707, 56.45540769257914, 59.26033354638187, 62.002579759711175, 64.80927617471649, 67.47489558309712,
+	70.19481933829526, 72.89878825376879, 75.53226913835451, 78.14195760432997, 80.72876420315906, 83.2935194834624, 85.87719846725153, 88.35985836078342,
+	90.90124702765094, 93.42076040878452, 92.91596637908805, 90.16884048949217, 87.58070341547484, 84.6483767242495, 81.77768551061067, 78.85166156160723,
+	75.7404667511258, 72.71056533011843, 69.47867141718622, 66.05241058800453, 62.88777671028074, 59.43608392652458, 56.302135332326564, 52.731963387944546,
+	49.08965093888521, 45.479282595159894, 42.001930561257, 38.39998796171187, 34.64351257353031, 30.814687043006398, 26.948620544004772, 23.074473219528343,
+	19.030302673174628, 14.948537198795835, 10.747571945590009, 4.132991276247545, 0.0, 0.0, 27.32683043175595, 33.803865572613134,
+	38.13236133461413, 41.49223384812225, 44.49020931149722, 47.497269822878955, 50.38765903680261, 53.296114329854475, 56.12517727302383, 58.9574129243544,
+	61.70758359909803, 64.45603361438584, 67.20462807608583, 69.84612319583619, 72.5286364939043, 75.1701075698468, 77.73125145715618, 80.32085196811727,
+	82.8838726304339, 85.43591050608849, 87.96709255313635, 90.45973069103698, 92.93970808647252, 95.38580716278433, 92.81947077630362, 89.85911085263339,
+	87.121851277463, 83.92903439157003, 80.87758612853813, 77.79171316133082, 74.3655975112968, 71.38442900078576, 67.92706028899114, 64.5022476491018,
+	61.06075805530681, 57.52266607492278, 54.15779671561825, 50.528707629724465, 46.795036392131024, 43.163104966889954, 39.076388090251974, 35.42058385451565,
+	31.480320992800312, 27.64276088221879, 23.686717550614173, 19.695639172197573, 15.278385563083932, 11.083892287946368, 4.132991276247545, 0.0,
+	0.0, 27.208799052201208, 33.64882176026845, 37.897502263018254, 41.27469370905122, 44.282299633625286, 47.21758608910883, 50.18816526411575,
+	53.004616404402626, 55.87078717519425, 58.66802641948413, 61.453960110656446, 64.1814399990856, 66.87015236771205, 69.52268305068584, 72.21716659867458,
+	74.8015805674341, 77.42843985601588, 79.9836311995716, 82.51848489054358, 85.05581594634648, 87.559105647325, 90.04931145028021, 92.50782810895701,
+	95.00544677995278, 95.37328265151956, 92.49537734487878, 89.38378205437974, 86.35965530508089, 83.19060224382898, 79.95292512995793, 76.79486389140425,
+	73.42008157429353, 69.93925314923862, 66.33980463668708, 62.87352734358552, 59.29028493585228, 55.42892316676338, 51.72851836545041, 47.96525223384508,
+	44.25359440949159, 40.139710150830474, 36.40548082835894, 32.53408199771895, 28.293795704666113, 24.385580203858147, 20.044403662901033, 15.978425872810737,
+	11.34839005988465, 3.498694802236145, 0.0, 0.0, 27.0534834616108, 33.4132195371626, 37.78769857450362, 41.06863279202297,
+	44.08451594166607, 47.028323411481445, 49.93608992252687, 52.82717790937072, 55.5964590069453, 58.43335189128602, 61.13130272912806, 63.86724258002948,
+	66.56466424986306, 69.22600700121008, 71.85347894118826, 74.51672907859265, 77.06901039504017, 79.67590321085963, 82.19416331098182, 84.72675642847473,
+	87.20660604129262, 89.67440674957597, 92.14005058013068, 94.59951905677596, 97.00882319128408, 95.46529101125286, 92.35557944228358, 89.02229659208494,
+	85.77782949616643, 82.42495400997517, 79.07028318524732, 75.49413691517218, 72.04461929928875, 68.44333079929609, 64.48847272090066, 60.91561986233224,
+	57.27804783854976, 53.340602922216576, 49.3776455034458, 45.3411795893965, 41.643720858689676, 37.47267148097378, 33.27124782016543, 29.032687167555792,
+	25.11790878889399, 20.771220881424426, 16.346582715198178, 10.88730808914627, 3.498694802236145, 0.0, 0.0, 26.94414922101496,
+	33.27236085691714, 37.58016324883019, 40.91977016129855, 43.897356431684855, 46.85297754983341, 49.720178704767754, 52.585068875520804, 55.37899857369878,
+	58.181432574690334, 60.90104238488325, 63.62785514938791, 66.28756460618466, 68.95556982957157, 71.58993113445189, 74.20486563643723, 76.76512066075558,
+	79.36804895895465, 81.8828350203378, 84.3882064235926, 86.89379356586457, 89.33424903458177, 91.79871394027813, 94.25788412841962, 96.6767105500959,
+	98.77531984397125, 95.45033527342301, 92.05278818170436, 88.83620548458138, 85.41556152550986, 81.63174158923594, 77.88674014482666, 74.40728822837335,
+	70.61264982251873, 66.68321585248262, 63.06782434071574, 58.966409524579056, 55.008493321743856, 51.05816651834109, 47.0000111849281, 42.83273753370371,
+	38.64284475066071, 34.425480546467696, 30.174351886134776, 25.826720362856506, 21.147450795534727, 16.597515907432964, 10.338688311101437, 3.498694802236145,
+	0.0, 0.0, 26.817253766443436, 33.11150676486344, 37.43050135360887, 40.734191457138174, 43.721441815827035, 46.61559026915496,
+	49.51529273622368, 52.376830983986736, 55.16680758886434, 57.926603788148185, 60.70997978257669, 63.391154521598395, 66.09804438419135, 68.70774964426144,
+	71.35028507422918, 73.95822765554306, 76.534218103938, 79.08614561357007, 81.60900014306424, 84.10618524206554, 86.57326516556488, 89.07682771361848,
+	91.50237573946254, 93.91640532606826, 96.33764258071868, 98.74285677325474, 99.03267414705398, 95.6326526903006, 92.05458969503884, 88.4154755520757,
+	84.52713045782357, 80.8683468199756, 77.25135443142278, 73.14160075037056, 69.17778731405278, 65.14543820152075, 61.221102780592666, 57.03374932718162,
+	52.792044068071874, 48.68806515697048, 44.49448298342813, 39.864507204888724, 35.63407868384198, 30.968192388362965, 26.672475898774472, 21.80560447472139,
+	15.706718018756971, 9.79433883446297, 2.879791569934482, 0.0, 0.0, 26.712666499619022, 33.01882210185669, 37.31835059442216,
+	40.56898867665205, 43.5934238946643, 46.474151315060425, 49.37019329996933, 52.23061183999441, 54.99222988494027, 57.75604297316387, 60.490563393998556,
+	63.19722480294819, 65.86938052767006, 68.4940920120918, 71.12544030107536, 73.69738453857559, 76.27908532072338, 78.83751130261338, 81.36239672306172,
+	83.8652003880147, 86.34360903217801, 88.79865408198775, 91.23129437908413, 93.64242138378268, 96.03286426463393, 98.4432394576917, 100.79208460080774,
+	99.61664284871013, 95.80162222189173, 91.8544542967762, 88.21402311243412, 84.15743434191518, 80.35668554379811, 76.05624751311363, 71.86633001211574,
+	67.74811290479244, 63.62279358003663, 59.14700966513056, 54.802244840700325, 50.45659766336238, 46.057468801354894, 41.56832024742147, 36.989238632726,
+	32.09206722220443, 26.151869567511216, 20.480974210543444, 14.70055871059862, 9.255634644804529, 2.879791569934482, 0.0, 0.0,
+	26.609716720100497, 32.93580085069725, 37.16573524264319, 40.4920392562139, 43.44958080378487, 46.34599100408854, 49.193015015116345, 52.03429979179849,
+	54.807775863715015, 57.576997358514426, 60.31088584020366, 63.00638980684229, 65.67406594550829, 68.29729876142054, 70.94130559975306, 73.51070548487519,
+	76.05328288038073, 78.61331463041476, 81.10845525877066, 83.61017623759992, 86.09342429745419, 88.55354011347988, 90.99141550822351, 93.39234755099156,
+	95.80114067184999, 98.1797144979985, 100.54284968923533, 102.87473175170322, 100.10399883586346, 96.12887904574691, 92.10369384337524, 87.75534155537713,
+	83.52759798357756, 79.31142435671154, 75.11893372690507, 70.80856141953913, 66.077791732785, 61.62710364008038, 57.01777681847232, 52.655342985738734,
+	47.191965231846936, 41.6146770508057, 36.113923171466915, 30.424289804560317, 25.232949627563766, 19.52993799438222, 14.20377835995906, 8.72428938151402,
+	2.879791569934482, 0.0, 0.0, 26.529937331579404, 32.7880798470795, 37.05081919704481, 40.37481807193088, 43.32770738877036,
+	46.22883216304485, 49.075266016231275, 51.88792153860073, 54.691618606808056, 57.434575457871276, 60.16973134074174, 62.83832739612433, 65.49310163439182,
+	68.13273931343308, 70.73255435703746, 73.3089993840875, 75.89506051488262, 78.41793634715685, 80.91654275509612, 83.42582102227476, 85.87950833906798,
+	88.34263865072947, 90.75054816859861, 93.17113527513027, 95.57134706994913, 97.94975309301901, 100.31331991942655, 102.65356574223432, 104.97438757370772,
+	100.78139351982212, 96.62405561048357, 92.14779292885422, 87.55793770307291, 83.19123292170856, 78.51617524545236, 73.83608655140989, 67.86984594272495,
+	62.155472287167356, 56.43432269344767, 50.8178294554283, 45.17775553195283, 39.955141603835706, 34.3246372029115, 29.107211752410404, 23.872060970582258,
+	18.592597930887045, 13.712158000576245, 8.202441241627321, 2.879791569934482, 0.0, 0.0, 26.455934530226056, 32.72331624947432,
+	36.97373460198784, 40.25753960823621, 43.218555992612664, 46.08303216899014, 48.961700237767026, 51.77989376791289, 54.56515053710012, 57.30960800134827,
+	60.009147287933445, 62.70624745373275, 65.37955811432829, 67.98292949219285, 70.59703828062098, 73.1715310684247, 75.72513218008409, 78.25333792040114,
+	80.7573213919944, 83.23816175256812, 85.72464317110669, 88.15375238607707, 90.57728190042408, 92.99959257773268, 95.37629427411534, 97.75942254808487,
+	100.12016704182739, 102.45018294812135, 104.7746541764208, 106.18374340194083, 101.65614783612996, 96.14496104778188, 89.76946943498196, 83.37272182923887,
+	77.14693288006035, 71.24641510382897, 65.40194585613465, 59.878403559664484, 54.217862120989764, 48.88150438348525, 43.5005565228764, 38.31610251934941,
+	33.08032832793644, 27.81010526387268, 22.979260894539188, 18.13010608159527, 12.747883614228087, 7.692756746760719, 2.3876941486284946, 0.0,
+	0.0, 26.381958197470905, 32.61191722818577, 36.862744137577565, 40.162334085831176, 43.08698922175053, 46.003376974880986, 48.86510648374561,
+	51.684826374449834, 54.45601396236841, 57.20404637489763, 59.90939337536389, 62.58187421090728, 65.25146250696744, 67.86267062961336, 70.47277254084432,
+	73.02952022487469, 75.58609618369273, 78.11760902956357, 80.6251416077604, 83.1097019915178, 85.57222751461744, 88.00817521132532, 90.4345970956537,
+	92.84034446771446, 95.23876544191567, 97.60642955371371, 99.94824000172582, 102.29590777845219, 104.61931450683012, 106.50851713917086, 99.80371067853687,
+	93.29119835594129, 87.04751984748037, 80.61287822375698, 74.86871359354568, 68.82586014125444, 63.30706197691893, 57.80311641887288, 52.256496507211004,
+	46.972006911208766, 41.623649153616846, 36.703453761668115, 31.82025643524359, 26.95890300584406, 22.100628427540098, 17.219906889588554, 12.277269864865085,
+	7.692756746760719, 2.3876941486284946, 0.0, 0.0, 26.32092519425263, 32.56529682307864, 36.786167790282015, 40.087080662752896,
+	43.017326904852, 45.93147892338468, 48.76838840213422, 51.591168592843076, 54.36570333120004, 57.11794548365315, 59.827423850022136, 62.50411774163799,
+	65.14777124095718, 67.76981193242484, 70.3771642061734, 72.93985350392165, 75.47806439968, 78.01084717775808, 80.5200908212782, 82.98917837573204,
+	85.45430651057238, 87.9001455211466, 90.32260039476718, 92.72801597331843, 95.11303446382706, 97.4806773861034, 99.83376804627778, 102.17794607772424,
+	104.49390624974168, 103.85009697656213, 97.16924008177946, 90.73204378789808, 84.3919337256024, 78.44749008112969, 72.29651001748618, 66.55333493040038,
+	61.17916897028516, 55.67295826458292, 50.53367084829993, 45.469028483727854, 40.08542566464489, 35.49068290083293, 30.600400255241087, 25.706630240860793,
+	21.23874427559358, 16.33318285085392, 11.880759319667735, 7.1018189086387595, 2.3876941486284946, 0.0, 0.0, 26.268560586344826,
+	32.517058128290486, 36.74317790138673, 40.022414458555986, 42.961989410247746, 45.87433941848517, 48.71864517867625, 51.521371878470475, 54.30554213115212,
+	57.051452343855935, 59.749405645619404, 62.42604599461568, 65.0756169630149, 67.69586607235364, 70.29527211992531, 72.86415705628758, 75.41073784215995,
+	77.93331158319725, 80.43016888251671, 82.91701501355729, 85.37120939450534, 87.81627828564534, 90.24156418344896, 92.63937241488888, 95.02503870313483,
+	97.3948230190277, 99.74604404409465, 102.08398506058819, 104.40207143323977, 101.30913337243385, 94.56494601767389, 88.23367265678931, 82.05397315330048,
+	76.00897181985637, 70.39735738810312, 64.58921989978992, 59.20928814818059, 53.917351584600056, 48.74131041103207, 43.628664734335246, 38.95351379612559,
+	33.96602531384098, 29.407893015227852, 24.89093526567844, 20.39653897595091, 15.974147943729347, 11.365225554836629, 6.723916929650583, 2.3876941486284946,
+	0.0, 0.0, 26.223587047764244, 32.462160232103514, 36.69725612781181, 39.98474932315929, 42.92386858143985, 45.81934734467034,
+	48.66645065490503, 51.488269672287544, 54.25829548708341, 56.99373364534111, 59.70620023844896, 62.38694385520374, 65.02850178996663, 67.65107304942883,
+	70.2467988985701, 72.81698494406314, 75.36282976428087, 77.88543335786714, 80.38775868601626, 82.86487499910695, 85.32895963183121, 87.76732796537294,
+	90.19193647364867, 92.59247360641598, 94.98010092051138, 97.34531494680851, 99.69754914276362, 102.03274066961323, 104.35036608210618, 98.95502788111334,
+	92.43335783903797, 86.04054264744163, 79.78473256088692, 73.91702522786383, 68.22398673658016, 62.713415425708604, 57.523789063508076, 52.225747205828,
+	47.33847305263537, 42.54879306287688, 37.47868400763144, 32.90266259087707, 28.630555356192893, 24.093063149981447, 19.72766126726597, 15.475158015019458,
+	10.926720130973967, 6.723916929650583, 2.1162652421743275, 0.0, 0.0, 26.190164318209362, 32.44023055711106, 36.66243539750487,
+	39.95117165709501, 42.896853841713735, 45.79399564521054, 48.646863764228705, 51.45822077254582, 54.233968630197154, 56.97412173224858, 59.68489805219521,
+	62.36125769008993, 65.01144575408465, 67.6314632974277, 70.22737285414266, 72.79702692179397, 75.34386683510111, 77.86798782745254, 80.36982274941597,
+	82.85072532453363, 85.31133243100868, 87.75231984289259, 90.17458755848432, 92.57798562068675, 94.9639963053263, 97.33294540942906, 99.68467397381222,
+	102.02048653543864, 103.65872013469546, 96.9298521443418, 90.18931827676293, 83.938018200533, 77.79815109230901, 72.05018720522327, 66.40589209330332,
+	61.09714267940211, 55.944230175940774, 50.89331336861141, 45.96821960489261, 41.14239088822454, 36.40240259463576, 32.0977911703453, 27.495174890512658,
+	23.207711420972533, 19.177483007009155, 14.65194602283171, 10.50213051701391, 6.273845817433594, 2.1162652421743275, 0.0, 0.0,
+	26.14829463635233, 32.41559679305224, 36.6539755290825, 39.944416639680824, 42.88784795131648, 45.785466419781756, 48.64088499563649, 51.45519688906101,
+	54.23248689746735, 56.97524717176715, 59.68602889408215, 62.36657645853334, 65.01366600361006, 67.63827655392934, 70.23755176534335, 72.80671251359233,
+	75.35259039509201, 77.88127939256667, 80.38293842174598, 82.86432067491135, 85.33074538561986, 87.77238589433358, 90.19543144411634, 92.60151518777687,
+	94.98737157176961, 97.3584296960196, 99.71202034640524, 102.04490616441383, 101.73294016183371, 94.7962830348882, 88.20876904307602, 82.00946160415363,
+	76.15877973144886, 70.17191325349557, 64.63782922891262, 59.568917080146505, 54.35592727463295, 49.274072826371544, 44.63558442943833, 39.77997265946134,
+	35.355484029714304, 31.020910381766143, 26.8278675282881, 22.560517057752882, 18.399853348191385, 14.255563744593971, 10.50213051701391, 6.273845817433594,
+	1.7998693816502804, 0.0, 0.0, 26.140742629382455, 32.41313708761949, 36.65142559197511, 39.94460697119334, 42.89462357056617,
+	45.799688981715825, 48.652158212101774, 51.46697226697501, 54.25217759551307, 56.997978026870754, 59.70968177687123, 62.39153877171227, 65.04524223167579,
+	67.67231498850984, 70.27412895734882, 72.85192286553477, 75.39868366611843, 77.9267852581835, 80.42603260627412, 82.9169026967302, 85.37747690484774,
+	87.82719289989448, 90.25033719701933, 92.65459506020895, 95.05371195240282, 97.42400729245382, 99.77815054389853, 102.11656133578087, 99.82029118963641,
+	93.03292583492023, 86.49532855846715, 80.32856290651002, 74.32695894778992, 68.79263427257293, 63.20912079001618, 58.08593119984344, 52.83209294256249,
+	48.033584736379176, 43.28524082085129, 38.79111362509094, 34.3416129621734, 30.061241113459825, 26.048144522499367, 21.831247651749162, 18.023150934390838,
+	13.870607043667137, 10.093360911945922, 6.273845817433594, 1.7998693816502804, 0.0, 0.0, 26.14337529343336, 32.424007320254866,
+	36.67081878065449, 39.96357897684258, 42.918212586843225, 45.827629396857056, 48.6958811983992, 51.50485288093033, 54.300964862410844, 57.04274504312779,
+	59.757632394813875, 62.45448933550226, 65.10887732035604, 67.73453011665455, 70.33689333323007, 72.91548930121635, 75.47333206576515, 78.00551201639155,
+	80.51879250925577, 82.99317218827917, 85.46688486077655, 87.92198062696217, 90.34514360472917, 92.76331319211765, 95.1519024476865, 97.53024349823099,
+	99.88221818640037, 102.23657214887332, 98.20233583191514, 91.36973442218539, 84.90965818755205, 78.59360060069139, 72.77270365836554, 67.19219909276188,
+	61.82694426799659, 56.6546718372744, 51.6554389964702, 46.811176492925675, 42.41056896407953, 37.83390855455754, 33.36471851502652, 29.31495441144729,
+	25.357773620382897, 21.13060209240436, 17.296877140068037, 13.498105425567143, 9.702494806467712, 5.854302050100882, 1.7998693816502804, 0.0,
+	0.0, 26.15607022518451, 32.44700849241556, 36.68903335030157, 40.00204109106924, 42.95901885389032, 45.870927251259516, 48.748016148969946,
+	51.5746728886691, 54.372705645841336, 57.11044315924958, 59.8446674383722, 62.522977077897394, 65.20045146631242, 67.83274574219831, 70.4268146363048,
+	73.03061334256502, 75.58537534996351, 78.11880901409059, 80.63171697586479, 83.12484688969849, 85.59889478331658, 88.05450864436077, 90.49229201616122,
+	92.88798177734125, 95.29173561648079, 97.67921644762362, 100.02681773264892, 102.37848371222894, 96.6595837965885, 89.83394357965463, 83.35808881285593,
+	77.19227070925683, 71.30256276739885, 65.65966879860153, 60.497161811654365, 55.282002796441446, 50.52101134519552, 45.64915507414878, 41.2610074586122,
+	36.911590930042564, 32.73603959023464, 28.668215149703432, 24.69238303147294, 20.792008941884802, 16.948539260311826, 13.139141422351184, 9.331782456073892,
+	5.854302050100882, 1.7998693816502804, 0.0, 0.0, 26.17918956294214, 32.47264781081399, 36.74315417497523, 40.06079792819605,
+	43.017691201234435, 45.965848430812635, 48.837306969191786, 51.671407051980744, 54.47083637819197, 57.20197074504339, 59.938491242411985, 62.6465222247499,
+	65.3276852469302, 67.9480022705288, 70.57906121477959, 73.15242617337199, 75.73888639175, 78.26818997596327, 80.77847620177798, 83.2694728412573,
+	85.77407441989767, 88.22780840900256, 90.6640670986748, 93.08335526776483, 95.48614986448307, 97.86928919121505, 100.24403441237841, 102.58896682421614,
+	95.32571563941613, 88.41894459652427, 81.9755672428502, 75.85382124505139, 70.13010371736482, 64.44100492646129, 59.22588879356976, 54.24541430342147,
+	49.43316361490814, 44.81226865919042, 40.34948809426677, 35.9913609481856, 31.830089080857093, 28.044324773895482, 24.05426090474634, 20.09905179806139,
+	16.610924546641193, 12.794843965003201, 9.331782456073892, 5.472192872945617, 1.7998693816502804, 0.0, 0.0, 26.211286601221712,
+	32.54667034135292, 36.82182563567987, 40.135213620979194, 43.09653013811907, 46.049968455047, 48.91375479230451, 51.752685439833535, 54.596525720869465,
+	57.36277890238889, 60.0991824459756, 62.80743605968596, 65.44538374146678, 68.10182719903656, 70.73406742600449, 73.34357026688211, 75.88907176710167,
+	78.4553100335049, 81.00135030980245, 83.48013434629482, 85.9545567980884, 88.44380700503973, 90.8761890732002, 93.29210465072455, 95.71522768308179,
+	98.11346048524564, 100.48179785092837, 101.38399398098517, 94.00950200793613, 87.15049178685868, 80.69127922232083, 74.58542402159154, 68.7934223569904,
+	63.281279431150885, 58.019430352319624, 53.22560605459488, 48.41588180262404, 43.73062764283967, 39.524862867156436, 35.185178224431596, 31.252749540818463,
+	27.155111380881547, 23.44577348585629, 19.82817070490781, 15.970536306945014, 12.49244609874808, 8.983614750955388, 5.472192872945617, 1.7998693816502804,
+	0.0, 0.0, 26.260193612180004, 32.611672922261874, 36.92627840853333, 40.24280463327739, 43.247298863809625, 46.151487082495606,
+	49.07246368540841, 51.90802846724561, 54.75101338946903, 57.513825655095665, 60.24757088374615, 62.95376529340618, 65.63258274589896, 68.28891955283727,
+	70.92029217833029, 73.52896052432732, 76.11588187236872, 78.68193164241315, 81.22791200445728, 83.74327237824947, 86.21448326510156, 88.70455760414886,
+	91.13053065102528, 93.57982108487535, 96.02546799673588, 98.40320136559885, 100.76630724344301, 100.28542123962924, 92.89914936671899, 85.93871828089623,
+	79.59717804864609, 73.43125869459436, 67.74373531483656, 62.18603002828466, 57.10523829070647, 52.27005862127864, 47.41519714588862, 42.98877744961914,
+	38.739512580223966, 34.648531269663934, 30.430023514874147, 26.59595437982575, 22.8693395157139, 19.233228641679638, 15.669126399496486, 12.154937296987145,
+	8.660480271228742, 5.472192872945617, 1.7998693816502804, 0.0,
+}
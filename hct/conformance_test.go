@@ -0,0 +1,183 @@
+package hct
+
+import (
+	"fmt"
+	"github.com/gio-eui/md3-colors/internal/golden"
+	colorUtils "github.com/gio-eui/md3-colors/utils/color"
+	mathUtils "github.com/gio-eui/md3-colors/utils/math"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+// The golden vectors in testdata are transcribed from the test suites of Material Color
+// Utilities, so that any divergence from the reference implementations fails go test.
+
+type valueRange struct {
+	From float64 `json:"from"`
+	To   float64 `json:"to"`
+	Step float64 `json:"step"`
+}
+
+func (r valueRange) values() []float64 {
+	var values []float64
+	for value := r.From; value <= r.To; value += r.Step {
+		values = append(values, value)
+	}
+	return values
+}
+
+func TestConformanceCam16(t *testing.T) {
+	var vectors struct {
+		Tolerance float64 `json:"tolerance"`
+		Cases     []struct {
+			Name   string      `json:"name"`
+			Argb   golden.Argb `json:"argb"`
+			Hue    float64     `json:"hue"`
+			Chroma float64     `json:"chroma"`
+			J      float64     `json:"j"`
+			M      float64     `json:"m"`
+			S      float64     `json:"s"`
+			Q      float64     `json:"q"`
+		} `json:"cases"`
+	}
+	golden.Load(t, "cam16.json", &vectors)
+	require.NotEmpty(t, vectors.Cases)
+
+	for _, c := range vectors.Cases {
+		cam := Cam16FromInt(int(c.Argb))
+		assert.InDelta(t, c.Hue, cam.GetHue(), vectors.Tolerance, c.Name)
+		assert.InDelta(t, c.Chroma, cam.GetChroma(), vectors.Tolerance, c.Name)
+		assert.InDelta(t, c.J, cam.GetJ(), vectors.Tolerance, c.Name)
+		assert.InDelta(t, c.M, cam.GetM(), vectors.Tolerance, c.Name)
+		assert.InDelta(t, c.S, cam.GetS(), vectors.Tolerance, c.Name)
+		assert.InDelta(t, c.Q, cam.GetQ(), vectors.Tolerance, c.Name)
+
+		// CAM16 round trips through ARGB and through CAM16-UCS, within a step per channel.
+		assertArgbNear(t, int(c.Argb), cam.ToInt(), c.Name)
+		ucs := Cam16FromUcs(cam.GetJstar(), cam.GetAstar(), cam.GetBstar())
+		assertArgbNear(t, int(c.Argb), ucs.ToInt(), c.Name)
+	}
+}
+
+func TestConformanceHct(t *testing.T) {
+	var vectors struct {
+		Tolerance float64 `json:"tolerance"`
+		FromArgb  []struct {
+			Name   string      `json:"name"`
+			Argb   golden.Argb `json:"argb"`
+			Hue    float64     `json:"hue"`
+			Chroma float64     `json:"chroma"`
+			Tone   float64     `json:"tone"`
+		} `json:"fromArgb"`
+		Solve []struct {
+			Name            string  `json:"name"`
+			RequestedHue    float64 `json:"requestedHue"`
+			RequestedChroma float64 `json:"requestedChroma"`
+			RequestedTone   float64 `json:"requestedTone"`
+			Hue             float64 `json:"hue"`
+			Chroma          float64 `json:"chroma"`
+			Tone            float64 `json:"tone"`
+		} `json:"solve"`
+		SolverSweep struct {
+			Hues            valueRange `json:"hues"`
+			Chromas         valueRange `json:"chromas"`
+			Tones           valueRange `json:"tones"`
+			HueTolerance    float64    `json:"hueTolerance"`
+			ChromaTolerance float64    `json:"chromaTolerance"`
+			ToneTolerance   float64    `json:"toneTolerance"`
+		} `json:"solverSweep"`
+	}
+	golden.Load(t, "hct.json", &vectors)
+
+	for _, c := range vectors.FromArgb {
+		hct := NewHctFromInt(int(c.Argb))
+		assert.InDelta(t, c.Hue, hct.GetHue(), vectors.Tolerance, c.Name)
+		assert.InDelta(t, c.Chroma, hct.GetChroma(), vectors.Tolerance, c.Name)
+		assert.InDelta(t, c.Tone, hct.GetTone(), vectors.Tolerance, c.Name)
+	}
+
+	for _, c := range vectors.Solve {
+		hct := NewHctResolved(c.RequestedHue, c.RequestedChroma, c.RequestedTone)
+		assert.InDelta(t, c.Hue, hct.GetHue(), vectors.Tolerance, c.Name)
+		assert.InDelta(t, c.Chroma, hct.GetChroma(), vectors.Tolerance, c.Name)
+		assert.InDelta(t, c.Tone, hct.GetTone(), vectors.Tolerance, c.Name)
+	}
+
+	sweep := vectors.SolverSweep
+	require.NotEmpty(t, sweep.Hues.values())
+	for _, hue := range sweep.Hues.values() {
+		for _, chroma := range sweep.Chromas.values() {
+			for _, tone := range sweep.Tones.values() {
+				request := fmt.Sprintf("H%v C%v T%v", hue, chroma, tone)
+				hct := NewHctResolved(hue, chroma, tone)
+				if chroma > 0 {
+					assert.InDelta(t, hue, hct.GetHue(), sweep.HueTolerance, request)
+				}
+				assert.GreaterOrEqual(t, hct.GetChroma(), 0.0, request)
+				assert.LessOrEqual(t, hct.GetChroma(), chroma+sweep.ChromaTolerance, request)
+				if hct.GetChroma() < chroma-sweep.ChromaTolerance {
+					assert.True(t, isOnSrgbBoundary(hct.ToInt()), request)
+				}
+				assert.InDelta(t, tone, hct.GetTone(), sweep.ToneTolerance, request)
+			}
+		}
+	}
+}
+
+func TestConformanceViewingConditions(t *testing.T) {
+	var vectors struct {
+		Tolerance float64 `json:"tolerance"`
+		Default   struct {
+			N      float64   `json:"n"`
+			Aw     float64   `json:"aw"`
+			Nbb    float64   `json:"nbb"`
+			Ncb    float64   `json:"ncb"`
+			C      float64   `json:"c"`
+			Nc     float64   `json:"nc"`
+			RgbD   []float64 `json:"rgbD"`
+			Fl     float64   `json:"fl"`
+			FlRoot float64   `json:"flRoot"`
+			Z      float64   `json:"z"`
+		} `json:"default"`
+	}
+	golden.Load(t, "viewing_conditions.json", &vectors)
+
+	expected := vectors.Default
+	vc := DefaultViewingConditions
+	assert.InDelta(t, expected.N, vc.GetN(), vectors.Tolerance)
+	assert.InDelta(t, expected.Aw, vc.GetAw(), vectors.Tolerance)
+	assert.InDelta(t, expected.Nbb, vc.GetNbb(), vectors.Tolerance)
+	assert.InDelta(t, expected.Ncb, vc.GetNcb(), vectors.Tolerance)
+	assert.InDelta(t, expected.C, vc.GetC(), vectors.Tolerance)
+	assert.InDelta(t, expected.Nc, vc.GetNc(), vectors.Tolerance)
+	assert.InDeltaSlice(t, expected.RgbD, vc.RgbD[:], vectors.Tolerance)
+	assert.InDelta(t, expected.Fl, vc.GetFl(), vectors.Tolerance)
+	assert.InDelta(t, expected.FlRoot, vc.GetFlRoot(), vectors.Tolerance)
+	assert.InDelta(t, expected.Z, vc.GetZ(), vectors.Tolerance)
+}
+
+func TestConformanceHctPreservesOriginalColor(t *testing.T) {
+	for argb := 0xff000000; argb <= 0xffffffff; argb += 0x010f0b {
+		hct := NewHctFromInt(argb)
+		reconstructed := NewHct(hct.GetHue(), hct.GetChroma(), hct.GetTone())
+		assert.Equal(t, argb, reconstructed.ToInt(), "%#x", argb)
+	}
+}
+
+func isOnSrgbBoundary(argb int) bool {
+	for _, component := range []int{colorUtils.RedFromArgb(argb), colorUtils.GreenFromArgb(argb), colorUtils.BlueFromArgb(argb)} {
+		if component == mathUtils.ClampInt(1, 254, component) {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+func assertArgbNear(t *testing.T, expected, actual int, name string) {
+	assert.Equal(t, colorUtils.AlphaFromArgb(expected), colorUtils.AlphaFromArgb(actual), name)
+	assert.InDelta(t, colorUtils.RedFromArgb(expected), colorUtils.RedFromArgb(actual), 1, name)
+	assert.InDelta(t, colorUtils.GreenFromArgb(expected), colorUtils.GreenFromArgb(actual), 1, name)
+	assert.InDelta(t, colorUtils.BlueFromArgb(expected), colorUtils.BlueFromArgb(actual), 1, name)
+}
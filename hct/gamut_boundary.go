@@ -0,0 +1,201 @@
+package hct
+
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"fmt"
+	colorUtils "github.com/gio-eui/md3-colors/utils/color"
+	mathUtils "github.com/gio-eui/md3-colors/utils/math"
+	"math"
+	"sync"
+)
+
+// MaxChroma returns the maximum chroma an sRGB color can have at the given hue and tone.
+//
+// Requesting more chroma than this from NewHct, or from a TonalPalette, clips the chroma.
+//
+// 0 <= [hue] < 360; invalid values are corrected.
+// 0 <= [tone] <= 100; black and white, at the ends of the range, have no chroma.
+func MaxChroma(hue, tone float64) float64 {
+	if tone < 0.0001 || tone > 99.9999 {
+		return 0.0
+	}
+	hueRadians := mathUtils.SanitizeDegreesDouble(hue) / 180 * math.Pi
+	linrgb := bisectToLimit(colorUtils.YFromLstar(tone), hueRadians)
+	cam := Cam16FromLinrgbInGamut(linrgb, colorUtils.GamutSrgb)
+	return cam.GetChroma()
+}
+
+// PeakTone returns the tone at which [hue] reaches its highest chroma in sRGB, and that
+// chroma.
+func PeakTone(hue float64) (float64, float64) {
+	peakTone := 50.0
+	peakChroma := MaxChroma(hue, peakTone)
+	for tone := 1.0; tone < 100.0; tone++ {
+		if chroma := MaxChroma(hue, tone); chroma > peakChroma {
+			peakTone = tone
+			peakChroma = chroma
+		}
+	}
+
+	// Golden-section search around the best integer tone.
+	invPhi := (math.Sqrt(5.0) - 1.0) / 2.0
+	low := math.Max(0.0001, peakTone-1.0)
+	high := math.Min(99.9999, peakTone+1.0)
+	for high-low > 0.001 {
+		left := high - invPhi*(high-low)
+		right := low + invPhi*(high-low)
+		if MaxChroma(hue, left) < MaxChroma(hue, right) {
+			low = left
+		} else {
+			high = right
+		}
+	}
+	if tone := (low + high) / 2.0; MaxChroma(hue, tone) > peakChroma {
+		peakTone = tone
+		peakChroma = MaxChroma(hue, tone)
+	}
+	return peakTone, peakChroma
+}
+
+// ChromaGrid is a precomputed table of MaxChroma over hue and tone, for fast approximate
+// queries.
+type ChromaGrid struct {
+	hueStep  float64
+	toneStep float64
+	hues     int
+	tones    int
+	chroma   []float64
+}
+
+var (
+	defaultChromaGrid     *ChromaGrid
+	defaultChromaGridOnce sync.Once
+)
+
+// MinChromaGridStep is the finest hue and tone step of a ChromaGrid. Finer grids cost more to
+// compute than they gain from interpolating between samples.
+const MinChromaGridStep = 0.25
+
+// NewChromaGrid computes a ChromaGrid sampling MaxChroma every [hueStep] degrees of hue and
+// every [toneStep] of tone. It returns an error unless [hueStep] divides 360 and [toneStep]
+// divides 100, both being at least MinChromaGridStep.
+func NewChromaGrid(hueStep, toneStep float64) (*ChromaGrid, error) {
+	if err := checkChromaGridStep("hue", hueStep, 360.0); err != nil {
+		return nil, err
+	}
+	if err := checkChromaGridStep("tone", toneStep, 100.0); err != nil {
+		return nil, err
+	}
+	return solveChromaGrid(hueStep, toneStep), nil
+}
+
+// checkChromaGridStep returns an error unless [step] divides [span] and is at least
+// MinChromaGridStep.
+func checkChromaGridStep(name string, step, span float64) error {
+	if !(step >= MinChromaGridStep && step <= span) {
+		return fmt.Errorf("invalid chroma grid %s step %v, want between %v and %v", name, step, MinChromaGridStep, span)
+	}
+	if n := span / step; math.Abs(n-math.Round(n)) > 1e-9 {
+		return fmt.Errorf("chroma grid %s step %v does not divide %v", name, step, span)
+	}
+	return nil
+}
+
+// solveChromaGrid implements NewChromaGrid for valid steps.
+func solveChromaGrid(hueStep, toneStep float64) *ChromaGrid {
+	grid := newChromaGridFromSamples(hueStep, toneStep, nil)
+	grid.chroma = make([]float64, grid.hues*grid.tones)
+	for h := 0; h < grid.hues; h++ {
+		for t := 0; t < grid.tones; t++ {
+			grid.chroma[h*grid.tones+t] = MaxChroma(float64(h)*hueStep, float64(t)*toneStep)
+		}
+	}
+	return grid
+}
+
+// newChromaGridFromSamples creates a ChromaGrid from samples laid out as returned by Samples.
+func newChromaGridFromSamples(hueStep, toneStep float64, samples []float64) *ChromaGrid {
+	return &ChromaGrid{
+		hueStep:  hueStep,
+		toneStep: toneStep,
+		hues:     int(math.Round(360.0 / hueStep)),
+		tones:    int(math.Round(100.0/toneStep)) + 1,
+		chroma:   samples,
+	}
+}
+
+// Resolution of DefaultChromaGrid.
+const (
+	DefaultChromaGridHueStep  = 2.0
+	DefaultChromaGridToneStep = 2.0
+)
+
+//go:generate go run ../cmd/md3gen -table chroma-grid -pkg hct -o chroma_grid.gen.go
+
+// defaultChromaGridSamples holds the samples of DefaultChromaGrid, assigned by chroma_grid.gen.go
+// from init. It stays nil while the generated file is missing, so that md3gen can still build
+// this package to regenerate it.
+var defaultChromaGridSamples []float64
+
+// DefaultChromaGrid returns a shared ChromaGrid with a resolution of DefaultChromaGridHueStep
+// degrees of hue and DefaultChromaGridToneStep of tone.
+//
+// The samples are precomputed by go generate, so the grid is available without running the
+// solver. Without the generated samples, the grid is computed on first use.
+func DefaultChromaGrid() *ChromaGrid {
+	defaultChromaGridOnce.Do(func() {
+		if defaultChromaGridSamples == nil {
+			defaultChromaGrid = solveChromaGrid(DefaultChromaGridHueStep, DefaultChromaGridToneStep)
+			return
+		}
+		defaultChromaGrid = newChromaGridFromSamples(
+			DefaultChromaGridHueStep, DefaultChromaGridToneStep, defaultChromaGridSamples)
+	})
+	return defaultChromaGrid
+}
+
+// MaxChroma returns the maximum chroma at the given hue and tone, bilinearly interpolated
+// between the samples of the grid. Use the MaxChroma function when an exact value is needed.
+func (g *ChromaGrid) MaxChroma(hue, tone float64) float64 {
+	huePosition := mathUtils.SanitizeDegreesDouble(hue) / g.hueStep
+	tonePosition := mathUtils.ClampDouble(0.0, 100.0, tone) / g.toneStep
+	h0 := int(math.Floor(huePosition)) % g.hues
+	h1 := (h0 + 1) % g.hues
+	t0 := mathUtils.ClampInt(0, g.tones-1, int(math.Floor(tonePosition)))
+	t1 := mathUtils.ClampInt(0, g.tones-1, t0+1)
+	hueAmount := huePosition - math.Floor(huePosition)
+	toneAmount := tonePosition - float64(t0)
+
+	low := mathUtils.Lerp(g.chroma[h0*g.tones+t0], g.chroma[h1*g.tones+t0], hueAmount)
+	high := mathUtils.Lerp(g.chroma[h0*g.tones+t1], g.chroma[h1*g.tones+t1], hueAmount)
+	return mathUtils.Lerp(low, high, toneAmount)
+}
+
+// Samples returns a copy of the MaxChroma samples of the grid, ordered by hue and then by
+// tone: the sample at hue index h and tone index t is at h*(100/toneStep+1)+t.
+func (g *ChromaGrid) Samples() []float64 {
+	return append([]float64(nil), g.chroma...)
+}
+
+// GetHueStep returns the hue resolution of the grid, in degrees.
+func (g *ChromaGrid) GetHueStep() float64 {
+	return g.hueStep
+}
+
+// GetToneStep returns the tone resolution of the grid.
+func (g *ChromaGrid) GetToneStep() float64 {
+	return g.toneStep
+}
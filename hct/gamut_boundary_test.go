@@ -0,0 +1,66 @@
+package hct
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math"
+	"testing"
+)
+
+func TestMaxChroma(t *testing.T) {
+	for _, hue := range []float64{0, 27.408, 90, 142.139, 209, 282.788} {
+		for _, tone := range []float64{5, 25, 50, 75, 95} {
+			maxChroma := MaxChroma(hue, tone)
+			achieved := NewHctFromInt(NewHct(hue, 200, tone).ToInt()).GetChroma()
+			assert.InDelta(t, achieved, maxChroma, 1.5, "hue %v tone %v", hue, tone)
+		}
+	}
+	assert.Equal(t, 0.0, MaxChroma(120, 0))
+	assert.Equal(t, 0.0, MaxChroma(120, 100))
+}
+
+func TestPeakTone(t *testing.T) {
+	// Pure sRGB primaries sit at the peak chroma of their hues.
+	tone, chroma := PeakTone(27.408)
+	assert.InDelta(t, 53.24, tone, 0.5)
+	assert.InDelta(t, 113.357, chroma, 0.5)
+
+	tone, chroma = PeakTone(282.788)
+	assert.InDelta(t, 32.30, tone, 0.5)
+	assert.InDelta(t, 87.23, chroma, 0.5)
+}
+
+func TestChromaGrid(t *testing.T) {
+	grid := DefaultChromaGrid()
+	assert.Same(t, grid, DefaultChromaGrid())
+	assert.Equal(t, 2.0, grid.GetHueStep())
+	assert.Equal(t, 2.0, grid.GetToneStep())
+
+	assert.InDelta(t, MaxChroma(120, 40), grid.MaxChroma(120, 40), 1e-9)
+	for _, hue := range []float64{13, 77, 199, 359.5} {
+		for _, tone := range []float64{15, 47, 83} {
+			assert.InDelta(t, MaxChroma(hue, tone), grid.MaxChroma(hue, tone), 3.0, "hue %v tone %v", hue, tone)
+		}
+	}
+}
+
+func TestDefaultChromaGridIsCurrent(t *testing.T) {
+	grid := DefaultChromaGrid()
+	solved, err := NewChromaGrid(grid.GetHueStep(), grid.GetToneStep())
+	require.NoError(t, err)
+	assert.InDeltaSlice(t, solved.Samples(), grid.Samples(), 1e-9, "run go generate")
+}
+
+func TestNewChromaGridRejectsInvalidSteps(t *testing.T) {
+	for _, step := range []float64{0, -2, math.NaN(), math.Inf(1), 1e-9, 0.1, 7, 400} {
+		_, err := NewChromaGrid(step, 2)
+		assert.Error(t, err, "hue step %v", step)
+		_, err = NewChromaGrid(2, step)
+		assert.Error(t, err, "tone step %v", step)
+	}
+
+	grid, err := NewChromaGrid(90, 25)
+	require.NoError(t, err)
+	assert.Len(t, grid.Samples(), 4*5)
+	assert.InDelta(t, MaxChroma(90, 50), grid.MaxChroma(90, 50), 1e-9)
+}
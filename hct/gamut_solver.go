@@ -0,0 +1,128 @@
+package hct
+
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	colorUtils "github.com/gio-eui/md3-colors/utils/color"
+	mathUtils "github.com/gio-eui/md3-colors/utils/math"
+	"math"
+)
+
+// findLinrgbByJInGamut finds a color with the given hue, chroma, and Y in [gamut].
+//
+// Returns the color in linear RGB coordinates of [gamut] and true, if found; and returns
+// false otherwise.
+func findLinrgbByJInGamut(hueRadians, chroma, y float64, gamut colorUtils.Gamut) ([3]float64, bool) {
+	j := math.Sqrt(y) * 11.0
+
+	viewingConditions := DefaultViewingConditions
+	tInnerCoeff := 1.0 / math.Pow(1.64-math.Pow(0.29, viewingConditions.N), 0.73)
+	eHue := 0.25 * (math.Cos(hueRadians+2.0) + 3.8)
+	p1 := eHue * (50000.0 / 13.0) * viewingConditions.Nc * viewingConditions.Ncb
+	hSin := math.Sin(hueRadians)
+	hCos := math.Cos(hueRadians)
+
+	for iterationRound := 0; iterationRound < 5; iterationRound++ {
+		jNormalized := j / 100.0
+		alpha := chroma / math.Sqrt(jNormalized)
+		t := math.Pow(alpha*tInnerCoeff, 1.0/0.9)
+		ac := viewingConditions.Aw * math.Pow(jNormalized, 1.0/(viewingConditions.C*viewingConditions.Z))
+		p2 := ac / viewingConditions.Nbb
+		gamma := 23.0 * (p2 + 0.305) * t / (23.0*p1 + 11*t*hCos + 108.0*t*hSin)
+		a := gamma * hCos
+		b := gamma * hSin
+		rA := (460.0*p2 + 451.0*a + 288.0*b) / 1403.0
+		gA := (460.0*p2 - 891.0*a - 261.0*b) / 1403.0
+		bA := (460.0*p2 - 220.0*a - 6300.0*b) / 1403.0
+		rF := inverseChromaticAdaptation(rA) * (100.0 / viewingConditions.Fl) / viewingConditions.RgbD[0]
+		gF := inverseChromaticAdaptation(gA) * (100.0 / viewingConditions.Fl) / viewingConditions.RgbD[1]
+		bF := inverseChromaticAdaptation(bA) * (100.0 / viewingConditions.Fl) / viewingConditions.RgbD[2]
+		xyz := mathUtils.MatrixMultiply([3]float64{rF, gF, bF}, &CAM16RGBToXYZ)
+		fnj := xyz[1]
+
+		if fnj <= 0 {
+			return [3]float64{}, false
+		}
+
+		if iterationRound == 4 || math.Abs(fnj-y) < 0.002 {
+			linrgb := gamut.LinrgbFromXyz(xyz[0], xyz[1], xyz[2])
+			if !gamut.Contains(linrgb) {
+				return [3]float64{}, false
+			}
+			return linrgb, true
+		}
+
+		j = j - (fnj-y)*j/(2*fnj)
+	}
+
+	return [3]float64{}, false
+}
+
+// solveToLinrgbInGamut finds a color in [gamut] with the given hue, chroma, and L*, if possible.
+//
+// Returns the linear RGB coordinates, in [gamut], of a color with its hue, chroma, and L*
+// sufficiently close to [hueDegrees], [chroma], and [lstar], respectively. If it is
+// impossible to satisfy all three constraints, the hue and L* will be sufficiently close,
+// and the chroma will be maximized.
+func solveToLinrgbInGamut(hueDegrees, chroma, lstar float64, gamut colorUtils.Gamut) [3]float64 {
+	y := colorUtils.YFromLstar(mathUtils.ClampDouble(0.0, 100.0, lstar))
+	gray := [3]float64{y, y, y}
+	if chroma < 0.0001 || lstar < 0.0001 || lstar > 99.9999 {
+		return gray
+	}
+	hueRadians := mathUtils.SanitizeDegreesDouble(hueDegrees) / 180 * math.Pi
+	if exactAnswer, ok := findLinrgbByJInGamut(hueRadians, chroma, y, gamut); ok {
+		return exactAnswer
+	}
+
+	// The requested chroma is out of the gamut; bisect towards the largest chroma that
+	// still fits, keeping hue and Y fixed.
+	best := gray
+	low := 0.0
+	high := chroma
+	for i := 0; i < 24; i++ {
+		mid := (low + high) / 2.0
+		if linrgb, ok := findLinrgbByJInGamut(hueRadians, mid, y, gamut); ok {
+			best = linrgb
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+	return best
+}
+
+// SolveInGamut finds a color in [gamut] with the given hue, chroma, and L*, if possible.
+//
+// Unlike NewHct, which is limited to sRGB, the chroma is only clipped to the boundary of
+// [gamut], so wide gamuts such as Display P3 and Rec.2020 reach more saturated colors.
+//
+// Returns the gamma-encoded R, G, and B components of the color in [gamut], each between
+// 0.0 and 1.0.
+func SolveInGamut(hueDegrees, chroma, lstar float64, gamut colorUtils.Gamut) []float64 {
+	linrgb := solveToLinrgbInGamut(hueDegrees, chroma, lstar, gamut)
+	return []float64{
+		gamut.Delinearized(linrgb[0]),
+		gamut.Delinearized(linrgb[1]),
+		gamut.Delinearized(linrgb[2]),
+	}
+}
+
+// Cam16FromLinrgbInGamut converts linear RGB coordinates of [gamut] to CAM16, assuming the
+// color was viewed in default viewing conditions.
+func Cam16FromLinrgbInGamut(linrgb [3]float64, gamut colorUtils.Gamut) Cam16 {
+	xyz := gamut.XyzFromLinrgb(linrgb)
+	return Cam16FromXyzInViewingConditions(xyz[0], xyz[1], xyz[2], DefaultViewingConditions)
+}
@@ -0,0 +1,49 @@
+package hct
+
+import (
+	colorUtils "github.com/gio-eui/md3-colors/utils/color"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func achievedInGamut(hue, chroma, tone float64, gamut colorUtils.Gamut) Cam16 {
+	linrgb := solveToLinrgbInGamut(hue, chroma, tone, gamut)
+	return Cam16FromLinrgbInGamut(linrgb, gamut)
+}
+
+func TestSolveInGamutSrgbMatchesSolveToInt(t *testing.T) {
+	for _, hue := range []float64{0, 27, 142, 209, 282} {
+		for _, tone := range []float64{10, 40, 60, 90} {
+			rgb := SolveInGamut(hue, 200, tone, colorUtils.GamutSrgb)
+			argb := colorUtils.ArgbFromRgbInGamut(rgb, colorUtils.GamutSrgb)
+			expected := solveToInt(hue, 200, tone)
+			assert.InDelta(t, colorUtils.RedFromArgb(expected), colorUtils.RedFromArgb(argb), 2)
+			assert.InDelta(t, colorUtils.GreenFromArgb(expected), colorUtils.GreenFromArgb(argb), 2)
+			assert.InDelta(t, colorUtils.BlueFromArgb(expected), colorUtils.BlueFromArgb(argb), 2)
+		}
+	}
+}
+
+func TestSolveInGamutWideGamutsReachMoreChroma(t *testing.T) {
+	for _, hue := range []float64{27, 142, 209, 282} {
+		srgb := achievedInGamut(hue, 200, 50, colorUtils.GamutSrgb)
+		p3 := achievedInGamut(hue, 200, 50, colorUtils.GamutDisplayP3)
+		rec2020 := achievedInGamut(hue, 200, 50, colorUtils.GamutRec2020)
+
+		assert.Greater(t, p3.GetChroma(), srgb.GetChroma())
+		assert.Greater(t, rec2020.GetChroma(), p3.GetChroma())
+		assert.InDelta(t, hue, p3.GetHue(), 1.0)
+		assert.InDelta(t, hue, rec2020.GetHue(), 1.0)
+	}
+}
+
+func TestSolveInGamutKeepsReachableColors(t *testing.T) {
+	cam := achievedInGamut(282.788, 40, 60, colorUtils.GamutDisplayP3)
+	assert.InDelta(t, 282.788, cam.GetHue(), 0.5)
+	assert.InDelta(t, 40, cam.GetChroma(), 0.5)
+
+	rgb := SolveInGamut(120, 30, 0, colorUtils.GamutRec2020)
+	assert.Equal(t, []float64{0, 0, 0}, rgb)
+	rgb = SolveInGamut(120, 30, 100, colorUtils.GamutRec2020)
+	assert.InDeltaSlice(t, []float64{1, 1, 1}, rgb, 1e-9)
+}
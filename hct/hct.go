@@ -16,6 +16,8 @@ package hct
 
 import (
 	colorUtils "github.com/gio-eui/md3-colors/utils/color"
+	mathUtils "github.com/gio-eui/md3-colors/utils/math"
+	"math"
 )
 
 // A color system built using CAM16 hue and chroma, and L* from L*a*b*.
@@ -34,6 +36,10 @@ import (
 // Hct hue, chroma, and tone. A color system that provides a perceptually accurate color
 // measurement system that can also accurately render what colors will appear as in different
 // lighting environments.
+//
+// Every method of Hct has a pointer receiver, and every function and method returning an HCT
+// color returns a *Hct. The With* methods return a modified copy and never change the receiver,
+// while the Set* methods modify the color in place.
 type Hct struct {
 	hue    float64
 	chroma float64
@@ -57,6 +63,63 @@ func NewHct(hue, chroma, tone float64) *Hct {
 	}
 }
 
+// NewHctResolved creates an HCT color from hue, chroma, and tone, like NewHct, but reports the
+// hue, chroma, and tone of the color that was actually produced.
+//
+// NewHct keeps the requested values, so when the requested chroma is out of reach GetChroma
+// claims a chroma the ARGB value does not have. NewHctResolved re-derives its state from the
+// solved ARGB value, matching Hct.from in Material Color Utilities.
+func NewHctResolved(hue, chroma, tone float64) *Hct {
+	return NewHctFromInt(solveToInt(hue, chroma, tone))
+}
+
+// SolveReport compares the hue, chroma, and tone requested from the HCT solver with the ones of
+// the color it produced.
+type SolveReport struct {
+	RequestedHue    float64
+	RequestedChroma float64
+	RequestedTone   float64
+	AchievedHue     float64
+	AchievedChroma  float64
+	AchievedTone    float64
+}
+
+// NewHctWithReport creates an HCT color from hue, chroma, and tone, like NewHctResolved, and
+// reports how far the produced color is from the request.
+func NewHctWithReport(hue, chroma, tone float64) (*Hct, SolveReport) {
+	hct := NewHctResolved(hue, chroma, tone)
+	return hct, SolveReport{
+		RequestedHue:    hue,
+		RequestedChroma: chroma,
+		RequestedTone:   tone,
+		AchievedHue:     hct.GetHue(),
+		AchievedChroma:  hct.GetChroma(),
+		AchievedTone:    hct.GetTone(),
+	}
+}
+
+// HueShift returns the distance, in degrees, between the requested and the achieved hue.
+func (r SolveReport) HueShift() float64 {
+	return mathUtils.DifferenceDegrees(mathUtils.SanitizeDegreesDouble(r.RequestedHue), r.AchievedHue)
+}
+
+// ChromaLoss returns how much less chroma than requested was achieved; it is negative when the
+// achieved chroma is higher.
+func (r SolveReport) ChromaLoss() float64 {
+	return r.RequestedChroma - r.AchievedChroma
+}
+
+// ToneShift returns the distance between the requested and the achieved tone.
+func (r SolveReport) ToneShift() float64 {
+	return math.Abs(r.RequestedTone - r.AchievedTone)
+}
+
+// IsChromaClipped returns whether the achieved chroma is lower than requested by more than
+// [tolerance].
+func (r SolveReport) IsChromaClipped(tolerance float64) bool {
+	return r.ChromaLoss() > tolerance
+}
+
 // NewHctFromInt creates an HCT color from an ARGB color representation.
 //
 // [argb] ARGB representation of a color.
@@ -118,6 +181,36 @@ func (h *Hct) SetTone(newTone float64) {
 	h.setInternalState(solveToInt(h.hue, h.chroma, newTone))
 }
 
+// WithHue returns a copy of the HCT color with a new hue, leaving the receiver unchanged.
+// Chroma may decrease because chroma has a different maximum for any given hue and tone.
+//
+// newHue 0 <= newHue < 360; invalid values are corrected.
+func (h *Hct) WithHue(newHue float64) *Hct {
+	c := *h
+	c.setInternalState(solveToInt(newHue, h.chroma, h.tone))
+	return &c
+}
+
+// WithChroma returns a copy of the HCT color with a new chroma, leaving the receiver unchanged.
+// Chroma may decrease because chroma has a different maximum for any given hue and tone.
+//
+// newChroma 0 <= newChroma < ?; Informally, colorfulness.
+func (h *Hct) WithChroma(newChroma float64) *Hct {
+	c := *h
+	c.setInternalState(solveToInt(h.hue, newChroma, h.tone))
+	return &c
+}
+
+// WithTone returns a copy of the HCT color with a new tone, leaving the receiver unchanged.
+// Chroma may decrease because chroma has a different maximum for any given hue and tone.
+//
+// newTone 0 <= newTone <= 100; invalid values are corrected.
+func (h *Hct) WithTone(newTone float64) *Hct {
+	c := *h
+	c.setInternalState(solveToInt(h.hue, h.chroma, newTone))
+	return &c
+}
+
 // InViewingConditions translates the color into different viewing conditions.
 //
 // Colors change appearance. They look different with lights on versus off, the same color, as
@@ -132,7 +225,7 @@ func (h *Hct) SetTone(newTone float64) {
 func (h *Hct) InViewingConditions(vc ViewingConditions) *Hct {
 	// 1. Use CAM16 to find XYZ coordinates of color in specified VC.
 	c16 := Cam16FromInt(h.ToInt())
-	viewedInVc := c16.XyzInViewingConditions(vc, nil)
+	viewedInVc := c16.xyzInViewingConditions(&vc)
 
 	// 2. Create CAM16 of those XYZ coordinates in default VC.
 	recastInVc := Cam16FromXyzInViewingConditions(viewedInVc[0], viewedInVc[1], viewedInVc[2], DefaultViewingConditions)
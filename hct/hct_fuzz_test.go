@@ -0,0 +1,90 @@
+package hct
+
+import (
+	colorUtils "github.com/gio-eui/md3-colors/utils/color"
+	mathUtils "github.com/gio-eui/md3-colors/utils/math"
+	"math"
+	"testing"
+)
+
+// Seed corpora for these targets live in testdata/fuzz; run a target with, for example,
+// go test ./hct -run '^$' -fuzz '^FuzzNewHct$'.
+
+func isFinite(values ...float64) bool {
+	for _, value := range values {
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			return false
+		}
+	}
+	return true
+}
+
+func FuzzNewHct(f *testing.F) {
+	f.Fuzz(func(t *testing.T, hue, chroma, tone float64) {
+		if !isFinite(hue, chroma, tone) || math.Abs(hue) > 1e6 || chroma < 0 || chroma > 1000 || tone < 0 || tone > 100 {
+			t.Skip()
+		}
+		argb := NewHct(hue, chroma, tone).ToInt()
+		if !colorUtils.IsOpaque(argb) {
+			t.Fatalf("NewHct(%v, %v, %v) = %#x is not opaque", hue, chroma, tone, argb)
+		}
+
+		achieved := NewHctFromInt(argb)
+		if !isFinite(achieved.GetHue(), achieved.GetChroma(), achieved.GetTone()) {
+			t.Fatalf("NewHct(%v, %v, %v) = %#x has non-finite HCT %v", hue, chroma, tone, argb, achieved)
+		}
+		if math.Abs(achieved.GetTone()-tone) > 1.0 {
+			t.Fatalf("NewHct(%v, %v, %v) = %#x has tone %v", hue, chroma, tone, argb, achieved.GetTone())
+		}
+		// Near black and white, rounding to 8-bit channels alone shifts hue and adds chroma.
+		if tone < 10 || tone > 90 {
+			return
+		}
+		// Grays have a small CAM16 chroma of their own, close to 3 near white.
+		if achieved.GetChroma() > chroma+3.0 {
+			t.Fatalf("NewHct(%v, %v, %v) = %#x has chroma %v", hue, chroma, tone, argb, achieved.GetChroma())
+		}
+		hueShift := mathUtils.DifferenceDegrees(mathUtils.SanitizeDegreesDouble(hue), achieved.GetHue())
+		if achieved.GetChroma() > 16.0 && hueShift > 4.0 {
+			t.Fatalf("NewHct(%v, %v, %v) = %#x has hue %v", hue, chroma, tone, argb, achieved.GetHue())
+		}
+	})
+}
+
+func FuzzNewHctFromInt(f *testing.F) {
+	f.Fuzz(func(t *testing.T, argb uint32) {
+		opaque := int(argb | 0xff000000)
+		hct := NewHctFromInt(opaque)
+		if !isFinite(hct.GetHue(), hct.GetChroma(), hct.GetTone()) {
+			t.Fatalf("NewHctFromInt(%#x) has non-finite HCT %v", opaque, hct)
+		}
+		if hct.GetHue() < 0 || hct.GetHue() >= 360 || hct.GetChroma() < 0 || hct.GetTone() < 0 || hct.GetTone() > 100.0001 {
+			t.Fatalf("NewHctFromInt(%#x) has out of range HCT %v", opaque, hct)
+		}
+
+		reconstructed := NewHct(hct.GetHue(), hct.GetChroma(), hct.GetTone()).ToInt()
+		for _, channel := range []func(int) int{colorUtils.RedFromArgb, colorUtils.GreenFromArgb, colorUtils.BlueFromArgb} {
+			if math.Abs(float64(channel(reconstructed)-channel(opaque))) > 1 {
+				t.Fatalf("NewHctFromInt(%#x) reconstructs as %#x", opaque, reconstructed)
+			}
+		}
+	})
+}
+
+func FuzzCam16FromUcs(f *testing.F) {
+	f.Fuzz(func(t *testing.T, jstar, astar, bstar float64) {
+		if !isFinite(jstar, astar, bstar) || jstar < 0 || jstar > 100 || math.Abs(astar) > 100 || math.Abs(bstar) > 100 {
+			t.Skip()
+		}
+		cam := Cam16FromUcs(jstar, astar, bstar)
+		if !isFinite(cam.GetHue(), cam.GetChroma(), cam.GetJ()) {
+			t.Fatalf("Cam16FromUcs(%v, %v, %v) has non-finite CAM16 %v", jstar, astar, bstar, cam)
+		}
+		if cam.GetHue() < 0 || cam.GetHue() >= 360 {
+			t.Fatalf("Cam16FromUcs(%v, %v, %v) has hue %v", jstar, astar, bstar, cam.GetHue())
+		}
+		if argb := cam.ToInt(); !colorUtils.IsOpaque(argb) {
+			t.Fatalf("Cam16FromUcs(%v, %v, %v) = %#x is not opaque", jstar, astar, bstar, argb)
+		}
+	})
+}
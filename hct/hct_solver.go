@@ -20,19 +20,19 @@ import (
 	"math"
 )
 
-var scaledDiscountFromLinrgb = [][]float64{
+var scaledDiscountFromLinrgb = [3][3]float64{
 	{0.001200833568784504, 0.002389694492170889, 0.0002795742885861124},
 	{0.0005891086651375999, 0.0029785502573438758, 0.0003270666104008398},
 	{0.00010146692491640572, 0.0005364214359186694, 0.0032979401770712076},
 }
 
-var linrgbFromScaledDiscount = [][]float64{
+var linrgbFromScaledDiscount = [3][3]float64{
 	{1373.2198709594231, -1100.4251190754821, -7.278681089101213},
 	{-271.815969077903, 559.6580465940733, -32.46047482791194},
 	{1.9622899599665666, -57.173814538844006, 308.7233197812385},
 }
 
-var yFromLinrgb = []float64{0.2126, 0.7152, 0.0722}
+var yFromLinrgb = [3]float64{0.2126, 0.7152, 0.0722}
 
 var criticalPlanes = []float64{
 	0.015176349177441876,
@@ -325,8 +325,8 @@ func chromaticAdaptation(component float64) float64 {
 }
 
 // hueOf returns the hue of [linrgb], a linear RGB color, in CAM16, in radians.
-func hueOf(linrgb []float64) float64 {
-	scaledDiscount := mathUtils.MatrixMultiply(linrgb, scaledDiscountFromLinrgb)
+func hueOf(linrgb [3]float64) float64 {
+	scaledDiscount := mathUtils.MatrixMultiply(linrgb, &scaledDiscountFromLinrgb)
 	rA := chromaticAdaptation(scaledDiscount[0])
 	gA := chromaticAdaptation(scaledDiscount[1])
 	bA := chromaticAdaptation(scaledDiscount[2])
@@ -348,8 +348,8 @@ func intercept(source, mid, target float64) float64 {
 	return (mid - source) / (target - source)
 }
 
-func lerpPoint(source []float64, t float64, target []float64) []float64 {
-	return []float64{
+func lerpPoint(source [3]float64, t float64, target [3]float64) [3]float64 {
+	return [3]float64{
 		source[0] + (target[0]-source[0])*t,
 		source[1] + (target[1]-source[1])*t,
 		source[2] + (target[2]-source[2])*t,
@@ -364,7 +364,7 @@ func lerpPoint(source []float64, t float64, target []float64) []float64 {
 // ... R = [coordinate] if [axis] == 0
 // ... G = [coordinate] if [axis] == 1
 // ... B = [coordinate] if [axis] == 2
-func setCoordinate(source []float64, coordinate float64, target []float64, axis int) []float64 {
+func setCoordinate(source [3]float64, coordinate float64, target [3]float64, axis int) [3]float64 {
 	t := intercept(source[axis], coordinate, target[axis])
 	return lerpPoint(source, t, target)
 }
@@ -380,7 +380,7 @@ func isBounded(x float64) bool {
 // intersection of the plane and the RGB cube, in linear RGB
 // coordinates, if it exists.
 // If this possible vertex lies outside of the cube, [-1.0, -1.0, -1.0] is returned.
-func nthVertex(y float64, n int) []float64 {
+func nthVertex(y float64, n int) [3]float64 {
 	kR := yFromLinrgb[0]
 	kG := yFromLinrgb[1]
 	kB := yFromLinrgb[2]
@@ -404,27 +404,27 @@ func nthVertex(y float64, n int) []float64 {
 		b := coordB
 		r := (y - g*kG - b*kB) / kR
 		if isBounded(r) {
-			return []float64{r, g, b}
+			return [3]float64{r, g, b}
 		} else {
-			return []float64{-1.0, -1.0, -1.0}
+			return [3]float64{-1.0, -1.0, -1.0}
 		}
 	} else if n < 8 {
 		b := coordA
 		r := coordB
 		g := (y - r*kR - b*kB) / kG
 		if isBounded(g) {
-			return []float64{r, g, b}
+			return [3]float64{r, g, b}
 		} else {
-			return []float64{-1.0, -1.0, -1.0}
+			return [3]float64{-1.0, -1.0, -1.0}
 		}
 	} else {
 		r := coordA
 		g := coordB
 		b := (y - r*kR - g*kG) / kB
 		if isBounded(b) {
-			return []float64{r, g, b}
+			return [3]float64{r, g, b}
 		} else {
-			return []float64{-1.0, -1.0, -1.0}
+			return [3]float64{-1.0, -1.0, -1.0}
 		}
 	}
 }
@@ -434,8 +434,8 @@ func nthVertex(y float64, n int) []float64 {
 // Given a plane Y = [y] and a desired [target_hue], returns the
 // segment containing the desired color, represented as an array of
 // its two endpoints.
-func bisectToSegment(y, targetHue float64) [][]float64 {
-	left := []float64{-1.0, -1.0, -1.0}
+func bisectToSegment(y, targetHue float64) [2][3]float64 {
+	left := [3]float64{-1.0, -1.0, -1.0}
 	right := left
 	leftHue := 0.0
 	rightHue := 0.0
@@ -470,11 +470,11 @@ func bisectToSegment(y, targetHue float64) [][]float64 {
 		}
 	}
 
-	return [][]float64{left, right}
+	return [2][3]float64{left, right}
 }
 
-func midpoint(a, b []float64) []float64 {
-	return []float64{
+func midpoint(a, b [3]float64) [3]float64 {
+	return [3]float64{
 		(a[0] + b[0]) / 2,
 		(a[1] + b[1]) / 2,
 		(a[2] + b[2]) / 2,
@@ -494,7 +494,7 @@ func criticalPlaneAbove(x float64) int {
 //
 // Returns the color with the desired Y value [y] and hue
 // [targetHue], in linear RGB coordinates.
-func bisectToLimit(y, targetHue float64) []float64 {
+func bisectToLimit(y, targetHue float64) [3]float64 {
 	segment := bisectToSegment(y, targetHue)
 	left := segment[0]
 	leftHue := hueOf(left)
@@ -567,7 +567,7 @@ func findResultByJ(hueRadians, chroma, y float64) int {
 		rCScaled := inverseChromaticAdaptation(rA)
 		gCScaled := inverseChromaticAdaptation(gA)
 		bCScaled := inverseChromaticAdaptation(bA)
-		linrgb := mathUtils.MatrixMultiply([]float64{rCScaled, gCScaled, bCScaled}, linrgbFromScaledDiscount)
+		linrgb := mathUtils.MatrixMultiply([3]float64{rCScaled, gCScaled, bCScaled}, &linrgbFromScaledDiscount)
 
 		if linrgb[0] < 0 || linrgb[1] < 0 || linrgb[2] < 0 {
 			return 0
@@ -0,0 +1,98 @@
+package hct
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewHctKeepsRequestedValues(t *testing.T) {
+	hct := NewHct(142.0, 200.0, 50.0)
+	assert.Equal(t, 200.0, hct.GetChroma())
+}
+
+func TestNewHctResolved(t *testing.T) {
+	hct := NewHctResolved(142.0, 200.0, 50.0)
+	produced := NewHctFromInt(hct.ToInt())
+
+	assert.Equal(t, NewHct(142.0, 200.0, 50.0).ToInt(), hct.ToInt())
+	assert.Equal(t, produced.GetHue(), hct.GetHue())
+	assert.Equal(t, produced.GetChroma(), hct.GetChroma())
+	assert.Equal(t, produced.GetTone(), hct.GetTone())
+	assert.Less(t, hct.GetChroma(), 200.0)
+}
+
+func TestNewHctWithReport(t *testing.T) {
+	hct, report := NewHctWithReport(142.0, 200.0, 50.0)
+	assert.Equal(t, 200.0, report.RequestedChroma)
+	assert.Equal(t, hct.GetChroma(), report.AchievedChroma)
+	assert.True(t, report.IsChromaClipped(1.0))
+	assert.Less(t, report.HueShift(), 1.0)
+	assert.Less(t, report.ToneShift(), 0.5)
+
+	_, report = NewHctWithReport(282.788, 16.0, 40.0)
+	assert.False(t, report.IsChromaClipped(1.0))
+	assert.InDelta(t, 0.0, report.ChromaLoss(), 1.0)
+
+	_, report = NewHctWithReport(-77.212, 16.0, 40.0)
+	assert.Less(t, report.HueShift(), 2.0)
+}
+
+func TestHctWithMethodsReturnCopies(t *testing.T) {
+	original := NewHctFromInt(0xff6750a4)
+	argb := original.ToInt()
+
+	lighter := original.WithTone(90)
+	assert.Equal(t, argb, original.ToInt())
+	assert.InDelta(t, 90.0, lighter.GetTone(), 0.5)
+	assert.InDelta(t, original.GetHue(), lighter.GetHue(), 2.0)
+
+	rotated := original.WithHue(original.GetHue() + 60)
+	assert.Equal(t, argb, original.ToInt())
+	assert.InDelta(t, original.GetTone(), rotated.GetTone(), 0.5)
+
+	muted := original.WithChroma(8).WithTone(30)
+	assert.Equal(t, argb, original.ToInt())
+	assert.InDelta(t, 8.0, muted.GetChroma(), 1.0)
+	assert.InDelta(t, 30.0, muted.GetTone(), 0.5)
+
+	lighter.SetTone(20)
+	assert.Equal(t, argb, original.ToInt())
+	assert.InDelta(t, 20.0, lighter.GetTone(), 0.5)
+}
+
+func TestHctConversionsDoNotAllocate(t *testing.T) {
+	assert.Zero(t, testing.AllocsPerRun(100, func() {
+		benchmarkArgb = NewHct(282.0, 48.0, 40.0).ToInt()
+	}))
+	assert.Zero(t, testing.AllocsPerRun(100, func() {
+		benchmarkArgb = int(NewHctFromInt(0xff6750a4).GetTone())
+	}))
+	assert.Zero(t, testing.AllocsPerRun(100, func() {
+		cam := Cam16FromInt(0xff6750a4)
+		benchmarkArgb = int(cam.GetHue())
+	}))
+}
+
+var benchmarkArgb int
+
+func BenchmarkNewHct(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchmarkArgb = NewHct(float64(i%360), 48.0, 40.0).ToInt()
+	}
+}
+
+func BenchmarkNewHctFromInt(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchmarkArgb = int(NewHctFromInt(0xff000000 | i&0xffffff).GetTone())
+	}
+}
+
+func BenchmarkCam16FromInt(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		cam := Cam16FromInt(0xff000000 | i&0xffffff)
+		benchmarkArgb = int(cam.GetHue())
+	}
+}
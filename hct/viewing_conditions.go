@@ -37,7 +37,7 @@ type ViewingConditions struct {
 	C      float64
 	Nc     float64
 	N      float64
-	RgbD   []float64
+	RgbD   [3]float64
 	Fl     float64
 	FlRoot float64
 	Z      float64
@@ -79,7 +79,7 @@ func MakeViewingConditions(whitePoint []float64, adaptingLuminance, backgroundLs
 	}
 
 	nc := f
-	rgbD := []float64{
+	rgbD := [3]float64{
 		d*(100.0/rW) + 1.0 - d,
 		d*(100.0/gW) + 1.0 - d,
 		d*(100.0/bW) + 1.0 - d,
@@ -93,12 +93,12 @@ func MakeViewingConditions(whitePoint []float64, adaptingLuminance, backgroundLs
 	z := 1.48 + math.Sqrt(n)
 	nbb := 0.725 / math.Pow(n, 0.2)
 	ncb := nbb
-	rgbAFactors := []float64{
+	rgbAFactors := [3]float64{
 		math.Pow(fl*rgbD[0]*rW/100.0, 0.42),
 		math.Pow(fl*rgbD[1]*gW/100.0, 0.42),
 		math.Pow(fl*rgbD[2]*bW/100.0, 0.42),
 	}
-	rgbA := []float64{
+	rgbA := [3]float64{
 		(400.0 * rgbAFactors[0]) / (rgbAFactors[0] + 27.13),
 		(400.0 * rgbAFactors[1]) / (rgbAFactors[1] + 27.13),
 		(400.0 * rgbAFactors[2]) / (rgbAFactors[2] + 27.13),
@@ -157,7 +157,7 @@ func (vc ViewingConditions) GetNc() float64 {
 }
 
 // GetRgbD returns the value of rgbD.
-func (vc ViewingConditions) GetRgbD() []float64 {
+func (vc ViewingConditions) GetRgbD() [3]float64 {
 	return vc.RgbD
 }
 
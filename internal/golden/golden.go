@@ -0,0 +1,52 @@
+// Package golden loads the golden vectors of the conformance tests, which are transcribed from
+// the test suites of Material Color Utilities into the testdata directory of each package.
+package golden
+
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+// Argb is a color in ARGB format, written in JSON as a hexadecimal string such as "0xff6750a4".
+type Argb int
+
+// UnmarshalJSON parses a hexadecimal string into the Argb.
+func (a *Argb) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+	value, err := strconv.ParseInt(text, 0, 64)
+	if err != nil {
+		return err
+	}
+	*a = Argb(value)
+	return nil
+}
+
+// Load decodes the golden file [name] of the testdata directory of the calling package into
+// [v], failing [t] on error.
+func Load(t testing.TB, name string, v interface{}) {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, v))
+}
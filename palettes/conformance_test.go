@@ -0,0 +1,51 @@
+package palettes
+
+import (
+	"github.com/gio-eui/md3-colors/internal/golden"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strconv"
+	"testing"
+)
+
+// The golden vectors in testdata are transcribed from the test suites of Material Color
+// Utilities, so that any divergence from the reference implementations fails go test.
+
+func TestConformancePalettes(t *testing.T) {
+	var vectors struct {
+		Palettes []struct {
+			Name    string                 `json:"name"`
+			Seed    golden.Argb            `json:"seed"`
+			Core    string                 `json:"core"`
+			Content bool                   `json:"content"`
+			Tones   map[string]golden.Argb `json:"tones"`
+		} `json:"palettes"`
+	}
+	golden.Load(t, "palettes.json", &vectors)
+	require.NotEmpty(t, vectors.Palettes)
+
+	for _, p := range vectors.Palettes {
+		palette := NewTonalPaletteFromInt(int(p.Seed))
+		if p.Core != "" {
+			core := NewCorePaletteFromInt(int(p.Seed))
+			if p.Content {
+				core = NewContentCorePaletteFromInt(int(p.Seed))
+			}
+			palette = map[string]*TonalPalette{
+				"a1":    core.A1,
+				"a2":    core.A2,
+				"a3":    core.A3,
+				"n1":    core.N1,
+				"n2":    core.N2,
+				"error": core.Error,
+			}[p.Core]
+			require.NotNil(t, palette, p.Name)
+		}
+
+		for toneText, expected := range p.Tones {
+			tone, err := strconv.ParseFloat(toneText, 64)
+			require.NoError(t, err)
+			assert.Equal(t, int(expected), palette.Tone(tone), "%s tone %s", p.Name, toneText)
+		}
+	}
+}
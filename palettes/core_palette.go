@@ -32,6 +32,10 @@ type CorePalette struct {
 // NewCorePaletteFromInt creates key tones from an ARGB color.
 // for example, NewCorePaletteFromInt(0xFF000000) will return a core palette with black tones.
 // NewCorePaletteFromInt(0xFFFF0000) will return a core palette with red tones.
+//
+// For the well-known seeds listed in the go:generate directive of this file, such as the
+// baseline 0xff6750a4, the integer tones are read from precomputed tone tables rather than
+// solved; the colors are the same.
 func NewCorePaletteFromInt(argb int) *CorePalette {
 	return newCorePalette(argb, false)
 }
@@ -39,12 +43,82 @@ func NewCorePaletteFromInt(argb int) *CorePalette {
 // NewContentCorePaletteFromInt creates content key tones from an ARGB color.
 // for example, NewContentCorePaletteFromInt(0xFF000000) will return a content core palette with black tones.
 // NewContentCorePaletteFromInt(0xFFFF0000) will return a content core palette with red tones.
+//
+// Like NewCorePaletteFromInt, well-known seeds are read from precomputed tone tables.
 func NewContentCorePaletteFromInt(argb int) *CorePalette {
 	return newCorePalette(argb, true)
 }
 
+// CorePaletteColors holds the key colors of the palettes of a CorePalette, in ARGB format.
+//
+// Only Primary is required; palettes whose key color is 0 are derived from Primary.
+type CorePaletteColors struct {
+	Primary        int
+	Secondary      int
+	Tertiary       int
+	Neutral        int
+	NeutralVariant int
+	Error          int
+}
+
+// NewCorePaletteFromColors creates key tones from independently chosen key colors. Each
+// palette with a key color is derived from it the way NewCorePaletteFromInt derives the
+// palette from its seed; the others are derived from the primary key color.
+func NewCorePaletteFromColors(colors CorePaletteColors) *CorePalette {
+	return newCorePaletteFromColors(colors, false)
+}
+
+// NewContentCorePaletteFromColors creates content key tones from independently chosen key
+// colors, like NewCorePaletteFromColors does with NewContentCorePaletteFromInt.
+func NewContentCorePaletteFromColors(colors CorePaletteColors) *CorePalette {
+	return newCorePaletteFromColors(colors, true)
+}
+
+// newCorePaletteFromColors creates a new CorePalette from key colors.
+func newCorePaletteFromColors(colors CorePaletteColors, isContent bool) *CorePalette {
+	corePalette := newCorePalette(colors.Primary, isContent)
+	if colors.Secondary != 0 {
+		corePalette.A2 = newCorePalette(colors.Secondary, isContent).A1
+	}
+	if colors.Tertiary != 0 {
+		corePalette.A3 = newCorePalette(colors.Tertiary, isContent).A1
+	}
+	if colors.Error != 0 {
+		corePalette.Error = newCorePalette(colors.Error, isContent).A1
+	}
+	if colors.Neutral != 0 {
+		corePalette.N1 = newCorePalette(colors.Neutral, isContent).N1
+	}
+	if colors.NeutralVariant != 0 {
+		corePalette.N2 = newCorePalette(colors.NeutralVariant, isContent).N2
+	}
+	return corePalette
+}
+
+//go:generate go run ../cmd/md3gen -table seeds -o seeds.gen.go Baseline=#6750a4 Blue=#4285f4 Red=#ea4335 Yellow=#fbbc05 Green=#34a853
+
+// seedToneTables and contentSeedToneTables hold the precomputed core tone tables of well-known
+// seeds, keyed by opaque ARGB seed, and are assigned by seeds.gen.go from init. They stay nil
+// while the generated file is missing, so that md3gen can still build this package to
+// regenerate it.
+var seedToneTables, contentSeedToneTables map[int]*CoreToneTable
+
 // newCorePalette creates a new CorePalette.
+//
+// Well-known seeds are read from precomputed tone tables instead of being solved.
 func newCorePalette(argb int, isContent bool) *CorePalette {
+	tables := seedToneTables
+	if isContent {
+		tables = contentSeedToneTables
+	}
+	if table, ok := tables[argb|0xff000000]; ok {
+		return NewCorePaletteFromToneTable(table)
+	}
+	return newSolvedCorePalette(argb, isContent)
+}
+
+// newSolvedCorePalette creates a new CorePalette whose tones are solved on demand.
+func newSolvedCorePalette(argb int, isContent bool) *CorePalette {
 	hct := hct2.Cam16FromInt(argb)
 	hue := hct.GetHue()
 	chroma := hct.GetChroma()
@@ -62,3 +62,41 @@ func TestNewCorePaletteFromInt(t *testing.T) {
 	assert.Equal(t, blueContent.A2.Tone(95), 0xfff1efff)
 	assert.Equal(t, blueContent.A2.Tone(100), 0xffffffff)
 }
+
+func TestNewCorePaletteFromColors(t *testing.T) {
+	primary := 0xff6750a4
+	secondary := 0xff00796b
+	errorColor := 0xffe65100
+	core := NewCorePaletteFromColors(CorePaletteColors{
+		Primary:   primary,
+		Secondary: secondary,
+		Error:     errorColor,
+	})
+
+	derived := NewCorePaletteFromInt(primary)
+	for _, tone := range []float64{10, 40, 90} {
+		assert.Equal(t, derived.A1.Tone(tone), core.A1.Tone(tone))
+		assert.Equal(t, NewCorePaletteFromInt(secondary).A1.Tone(tone), core.A2.Tone(tone))
+		assert.Equal(t, derived.A3.Tone(tone), core.A3.Tone(tone))
+		assert.Equal(t, derived.N1.Tone(tone), core.N1.Tone(tone))
+		assert.Equal(t, derived.N2.Tone(tone), core.N2.Tone(tone))
+		assert.Equal(t, NewCorePaletteFromInt(errorColor).A1.Tone(tone), core.Error.Tone(tone))
+	}
+	assert.NotEqual(t, derived.Error.Tone(40), core.Error.Tone(40))
+}
+
+func TestNewCorePaletteFromColorsNeutrals(t *testing.T) {
+	neutral := 0xff8d6e63
+	neutralVariant := 0xff546e7a
+	core := NewContentCorePaletteFromColors(CorePaletteColors{
+		Primary:        0xff6750a4,
+		Tertiary:       0xff00796b,
+		Neutral:        neutral,
+		NeutralVariant: neutralVariant,
+	})
+
+	assert.Equal(t, NewContentCorePaletteFromInt(0xff00796b).A1.Tone(40), core.A3.Tone(40))
+	assert.Equal(t, NewContentCorePaletteFromInt(neutral).N1.Tone(40), core.N1.Tone(40))
+	assert.Equal(t, NewContentCorePaletteFromInt(neutralVariant).N2.Tone(40), core.N2.Tone(40))
+	assert.Equal(t, NewContentCorePaletteFromInt(0xff6750a4).A2.Tone(40), core.A2.Tone(40))
+}
@@ -0,0 +1,1180 @@
+// Code generated by md3gen. DO NOT EDIT.
+
+package palettes
+
+func init() {
+	seedToneTables = map[int]*CoreToneTable{
+		// Baseline
+		0xff6750a4: {
+			A1: ToneTable{
+				Hue:    298.98099721070395,
+				Chroma: 48.0,
+				Tones: [101]int{
+					0xff000000, 0xff05001b, 0xff0a0028, 0xff0f0032, 0xff13003a, 0xff160041, 0xff190048, 0xff1b004d,
+					0xff1d0053, 0xff200058, 0xff22005d, 0xff240260, 0xff260561, 0xff280963, 0xff2b0d65, 0xff2d1067,
+					0xff2f136a, 0xff31166c, 0xff33196e, 0xff351c70, 0xff381e72, 0xff3a2175, 0xff3c2377, 0xff3e2679,
+					0xff41287c, 0xff432b7e, 0xff452d81, 0xff483083, 0xff4a3285, 0xff4c3588, 0xff4f378a, 0xff513a8d,
+					0xff533c8f, 0xff563f92, 0xff584194, 0xff5b4397, 0xff5d469a, 0xff5f489c, 0xff624b9f, 0xff644da1,
+					0xff6750a4, 0xff6952a7, 0xff6c55a9, 0xff6e57ac, 0xff715aaf, 0xff735cb1, 0xff765fb4, 0xff7861b7,
+					0xff7b64ba, 0xff7e66bc, 0xff8069bf, 0xff836bc2, 0xff856ec5, 0xff8870c7, 0xff8b73ca, 0xff8d76cd,
+					0xff9078d0, 0xff927bd2, 0xff957dd5, 0xff9880d8, 0xff9a83db, 0xff9d85de, 0xffa088e1, 0xffa28ae4,
+					0xffa58de6, 0xffa890e9, 0xffab92ec, 0xffad95ef, 0xffb098f2, 0xffb39af5, 0xffb69df8, 0xffb8a0fb,
+					0xffbba2fe, 0xffbea5ff, 0xffc0a9ff, 0xffc3acff, 0xffc5afff, 0xffc8b3ff, 0xffcab6ff, 0xffcdb9ff,
+					0xffcfbcff, 0xffd2c0ff, 0xffd4c3ff, 0xffd7c6ff, 0xffd9caff, 0xffdccdff, 0xffded0ff, 0xffe1d3ff,
+					0xffe4d7ff, 0xffe6daff, 0xffe9ddff, 0xffebe0ff, 0xffeee4ff, 0xfff0e7ff, 0xfff3eaff, 0xfff6eeff,
+					0xfff8f1ff, 0xfffbf4ff, 0xfffdf7ff, 0xfffffbff, 0xffffffff,
+				},
+			},
+			A2: ToneTable{
+				Hue:    298.98099721070395,
+				Chroma: 16.0,
+				Tones: [101]int{
+					0xff000000, 0xff050210, 0xff090515, 0xff0d081a, 0xff100b1d, 0xff130e20, 0xff151022, 0xff181325,
+					0xff1a1527, 0xff1c1729, 0xff1e192b, 0xff201b2d, 0xff221d2f, 0xff241f31, 0xff262133, 0xff282336,
+					0xff2a2538, 0xff2c273a, 0xff2f293c, 0xff312b3e, 0xff332d41, 0xff353043, 0xff373245, 0xff3a3448,
+					0xff3c364a, 0xff3e384c, 0xff403b4f, 0xff433d51, 0xff453f53, 0xff474156, 0xff4a4458, 0xff4c465a,
+					0xff4e485d, 0xff514b5f, 0xff534d62, 0xff564f64, 0xff585267, 0xff5a5469, 0xff5d566c, 0xff5f596e,
+					0xff625b71, 0xff645d73, 0xff676076, 0xff696278, 0xff6c657b, 0xff6e677d, 0xff716a80, 0xff736c82,
+					0xff766f85, 0xff787187, 0xff7b748a, 0xff7d768d, 0xff80798f, 0xff827b92, 0xff857e94, 0xff888097,
+					0xff8a839a, 0xff8d859c, 0xff90889f, 0xff928aa2, 0xff958da4, 0xff9790a7, 0xff9a92aa, 0xff9d95ac,
+					0xffa097af, 0xffa29ab2, 0xffa59db5, 0xffa89fb7, 0xffaaa2ba, 0xffada5bd, 0xffb0a7c0, 0xffb3aac2,
+					0xffb5adc5, 0xffb8afc8, 0xffbbb2cb, 0xffbeb5cd, 0xffc0b8d0, 0xffc3bad3, 0xffc6bdd6, 0xffc9c0d9,
+					0xffcbc2db, 0xffcec5de, 0xffd1c8e1, 0xffd4cbe4, 0xffd7cde7, 0xffdad0ea, 0xffdcd3ed, 0xffdfd6ef,
+					0xffe2d9f2, 0xffe5dbf5, 0xffe8def8, 0xffebe1fb, 0xffeee4fe, 0xfff0e7ff, 0xfff3eaff, 0xfff6eeff,
+					0xfff8f1ff, 0xfffbf4ff, 0xfffdf7ff, 0xfffffbff, 0xffffffff,
+				},
+			},
+			A3: ToneTable{
+				Hue:    358.98099721070395,
+				Chroma: 24.0,
+				Tones: [101]int{
+					0xff000000, 0xff0f0004, 0xff170108, 0xff1d020c, 0xff210410, 0xff240612, 0xff270815, 0xff2a0a17,
+					0xff2c0c19, 0xff2f0e1b, 0xff31101d, 0xff33121f, 0xff361421, 0xff381623, 0xff3b1925, 0xff3d1b27,
+					0xff401d29, 0xff421f2c, 0xff45212e, 0xff472330, 0xff4a2532, 0xff4c2734, 0xff4f2936, 0xff512b39,
+					0xff542e3b, 0xff56303d, 0xff59323f, 0xff5b3441, 0xff5e3644, 0xff613946, 0xff633b48, 0xff663d4b,
+					0xff683f4d, 0xff6b424f, 0xff6e4452, 0xff704654, 0xff734856, 0xff764b59, 0xff784d5b, 0xff7b4f5e,
+					0xff7e5260, 0xff805462, 0xff835765, 0xff865967, 0xff885b6a, 0xff8b5e6c, 0xff8e606f, 0xff916371,
+					0xff936574, 0xff966776, 0xff996a79, 0xff9c6c7b, 0xff9e6f7e, 0xffa17180, 0xffa47483, 0xffa77685,
+					0xffaa7988, 0xffac7b8a, 0xffaf7e8d, 0xffb28090, 0xffb58392, 0xffb88695, 0xffbb8897, 0xffbd8b9a,
+					0xffc08d9d, 0xffc3909f, 0xffc692a2, 0xffc995a5, 0xffcc98a7, 0xffcf9aaa, 0xffd29dad, 0xffd5a0af,
+					0xffd8a2b2, 0xffdaa5b5, 0xffdda8b7, 0xffe0aaba, 0xffe3adbd, 0xffe6b0c0, 0xffe9b2c2, 0xffecb5c5,
+					0xffefb8c8, 0xfff2bacb, 0xfff5bdcd, 0xfff8c0d0, 0xfffbc3d3, 0xfffec5d6, 0xffffc9d8, 0xffffcddb,
+					0xffffd1de, 0xffffd5e0, 0xffffd9e3, 0xffffdde5, 0xffffe1e8, 0xffffe4eb, 0xffffe8ed, 0xffffecf0,
+					0xfffff0f2, 0xfffff4f5, 0xfffff8f8, 0xfffffbff, 0xffffffff,
+				},
+			},
+			N1: ToneTable{
+				Hue:    298.98099721070395,
+				Chroma: 4.0,
+				Tones: [101]int{
+					0xff000000, 0xff040305, 0xff08070a, 0xff0c0a0e, 0xff0f0e11, 0xff121014, 0xff141316, 0xff161518,
+					0xff18171a, 0xff1a191c, 0xff1c1b1e, 0xff1e1d20, 0xff201f22, 0xff232125, 0xff252327, 0xff272529,
+					0xff29272b, 0xff2b292d, 0xff2d2b2f, 0xff2f2d31, 0xff313033, 0xff343236, 0xff363438, 0xff38363a,
+					0xff3a383c, 0xff3d3b3e, 0xff3f3d41, 0xff413f43, 0xff434145, 0xff464448, 0xff48464a, 0xff4a484c,
+					0xff4d4a4e, 0xff4f4d51, 0xff524f53, 0xff545156, 0xff565458, 0xff59565a, 0xff5b595d, 0xff5e5b5f,
+					0xff605d62, 0xff636064, 0xff656266, 0xff676569, 0xff6a676b, 0xff6c696e, 0xff6f6c70, 0xff716e73,
+					0xff747175, 0xff777378, 0xff79767a, 0xff7c787d, 0xff7e7b7f, 0xff817d82, 0xff838084, 0xff868387,
+					0xff89858a, 0xff8b888c, 0xff8e8a8f, 0xff908d91, 0xff938f94, 0xff969297, 0xff989599, 0xff9b979c,
+					0xff9e9a9e, 0xffa09da1, 0xffa39fa4, 0xffa6a2a6, 0xffa9a4a9, 0xffaba7ac, 0xffaeaaae, 0xffb1adb1,
+					0xffb3afb4, 0xffb6b2b7, 0xffb9b5b9, 0xffbcb7bc, 0xffbebabf, 0xffc1bdc1, 0xffc4bfc4, 0xffc7c2c7,
+					0xffcac5ca, 0xffccc8cd, 0xffcfcbcf, 0xffd2cdd2, 0xffd5d0d5, 0xffd8d3d8, 0xffdbd6da, 0xffddd8dd,
+					0xffe0dbe0, 0xffe3dee3, 0xffe6e1e6, 0xffe9e4e9, 0xffece7eb, 0xffefe9ee, 0xfff2ecf1, 0xfff4eff4,
+					0xfff7f2f7, 0xfffaf5fa, 0xfffdf8fd, 0xfffffbff, 0xffffffff,
+				},
+			},
+			N2: ToneTable{
+				Hue:    298.98099721070395,
+				Chroma: 8.0,
+				Tones: [101]int{
+					0xff000000, 0xff040308, 0xff08060d, 0xff0c0a11, 0xff0f0d14, 0xff121017, 0xff14121a, 0xff17141c,
+					0xff19161e, 0xff1b1820, 0xff1d1a22, 0xff1f1c24, 0xff211e26, 0xff232028, 0xff25222b, 0xff27242d,
+					0xff29272f, 0xff2b2931, 0xff2e2b33, 0xff302d35, 0xff322f38, 0xff34313a, 0xff36333c, 0xff39363e,
+					0xff3b3840, 0xff3d3a43, 0xff3f3c45, 0xff423e47, 0xff44414a, 0xff46434c, 0xff49454e, 0xff4b4751,
+					0xff4d4a53, 0xff504c55, 0xff524e58, 0xff54515a, 0xff57535c, 0xff59565f, 0xff5c5861, 0xff5e5a64,
+					0xff615d66, 0xff635f69, 0xff65616b, 0xff68646e, 0xff6a6670, 0xff6d6973, 0xff6f6b75, 0xff726e78,
+					0xff75707a, 0xff77737d, 0xff7a757f, 0xff7c7882, 0xff7f7a84, 0xff817d87, 0xff847f89, 0xff87828c,
+					0xff89848f, 0xff8c8791, 0xff8e8a94, 0xff918c96, 0xff948f99, 0xff96919c, 0xff99949e, 0xff9c97a1,
+					0xff9e99a4, 0xffa19ca6, 0xffa49ea9, 0xffa6a1ac, 0xffa9a4ae, 0xffaca6b1, 0xffafa9b4, 0xffb1acb7,
+					0xffb4aeb9, 0xffb7b1bc, 0xffb9b4bf, 0xffbcb7c1, 0xffbfb9c4, 0xffc2bcc7, 0xffc5bfca, 0xffc7c1cd,
+					0xffcac4cf, 0xffcdc7d2, 0xffd0cad5, 0xffd3cdd8, 0xffd5cfda, 0xffd8d2dd, 0xffdbd5e0, 0xffded8e3,
+					0xffe1dae6, 0xffe4dde9, 0xffe7e0eb, 0xffe9e3ee, 0xffece6f1, 0xffefe9f4, 0xfff2ebf7, 0xfff5eefa,
+					0xfff8f1fd, 0xfffbf4ff, 0xfffdf7ff, 0xfffffbff, 0xffffffff,
+				},
+			},
+			Error: ToneTable{
+				Hue:    25.0,
+				Chroma: 84.0,
+				Tones: [101]int{
+					0xff000000, 0xff100000, 0xff1a0000, 0xff210001, 0xff280001, 0xff2d0001, 0xff310001, 0xff360001,
+					0xff390001, 0xff3d0002, 0xff410002, 0xff450002, 0xff490002, 0xff4d0002, 0xff500003, 0xff540003,
+					0xff580003, 0xff5c0004, 0xff600004, 0xff640004, 0xff690005, 0xff6d0005, 0xff710005, 0xff750006,
+					0xff790006, 0xff7e0007, 0xff820007, 0xff860008, 0xff8a0008, 0xff8f0009, 0xff93000a, 0xff98000a,
+					0xff9c000b, 0xffa0000c, 0xffa4020d, 0xffa80710, 0xffac0c12, 0xffaf1014, 0xffb31416, 0xffb61718,
+					0xffba1a1a, 0xffbd1e1d, 0xffc1211f, 0xffc52421, 0xffc82623, 0xffcc2925, 0xffcf2c27, 0xffd32f29,
+					0xffd7322c, 0xffda342e, 0xffde3730, 0xffe13a32, 0xffe53c34, 0xffe93f36, 0xffec4139, 0xfff0443b,
+					0xfff3473d, 0xfff7493f, 0xfffb4c42, 0xfffe4e44, 0xffff5449, 0xffff5b4f, 0xffff6154, 0xffff665a,
+					0xffff6c5f, 0xffff7164, 0xffff7669, 0xffff7b6e, 0xffff8073, 0xffff8578, 0xffff897d, 0xffff8e82,
+					0xffff9286, 0xffff978b, 0xffff9b90, 0xffff9f94, 0xffffa399, 0xffffa89d, 0xffffaca2, 0xffffb0a6,
+					0xffffb4ab, 0xffffb8af, 0xffffbcb3, 0xffffbfb8, 0xffffc3bc, 0xffffc7c0, 0xffffcbc5, 0xffffcfc9,
+					0xffffd3cd, 0xffffd6d1, 0xffffdad6, 0xffffdeda, 0xffffe2de, 0xffffe5e2, 0xffffe9e6, 0xffffedea,
+					0xfffff0ee, 0xfffff4f2, 0xfffff8f7, 0xfffffbff, 0xffffffff,
+				},
+			},
+		},
+		// Blue
+		0xff4285f4: {
+			A1: ToneTable{
+				Hue:    265.97939535792614,
+				Chroma: 62.26911127457101,
+				Tones: [101]int{
+					0xff000000, 0xff000310, 0xff00071a, 0xff000a22, 0xff000d28, 0xff00102d, 0xff001232, 0xff001436,
+					0xff00163a, 0xff00183d, 0xff001a41, 0xff001c45, 0xff001e49, 0xff00204d, 0xff002251, 0xff002455,
+					0xff002659, 0xff00285d, 0xff002a61, 0xff002c65, 0xff002e69, 0xff00306d, 0xff003271, 0xff003576,
+					0xff00377a, 0xff00397e, 0xff003b82, 0xff003d87, 0xff003f8b, 0xff00428f, 0xff004494, 0xff004698,
+					0xff00489d, 0xff004aa1, 0xff004da6, 0xff004faa, 0xff0051af, 0xff0054b3, 0xff0056b8, 0xff0058bd,
+					0xff005ac1, 0xff005dc6, 0xff005fcb, 0xff0162cf, 0xff0a64d2, 0xff1267d4, 0xff1869d7, 0xff1e6cda,
+					0xff226fdc, 0xff2771df, 0xff2b74e2, 0xff2f76e5, 0xff3279e7, 0xff367cea, 0xff397eed, 0xff3d81f0,
+					0xff4084f2, 0xff4386f5, 0xff4789f8, 0xff4a8cfb, 0xff4d8efe, 0xff5291ff, 0xff5894ff, 0xff5d97ff,
+					0xff629aff, 0xff689cff, 0xff6d9fff, 0xff72a2ff, 0xff77a5ff, 0xff7ba8ff, 0xff80aaff, 0xff85adff,
+					0xff89b0ff, 0xff8eb3ff, 0xff93b6ff, 0xff97b8ff, 0xff9cbbff, 0xffa0beff, 0xffa4c1ff, 0xffa9c4ff,
+					0xffadc6ff, 0xffb1c9ff, 0xffb6ccff, 0xffbacfff, 0xffbed2ff, 0xffc3d4ff, 0xffc7d7ff, 0xffcbdaff,
+					0xffcfddff, 0xffd4dfff, 0xffd8e2ff, 0xffdce5ff, 0xffe0e8ff, 0xffe4ebff, 0xffe9edff, 0xffedf0ff,
+					0xfff1f3ff, 0xfff5f6ff, 0xfff9f9ff, 0xfffefbff, 0xffffffff,
+				},
+			},
+			A2: ToneTable{
+				Hue:    265.97939535792614,
+				Chroma: 16.0,
+				Tones: [101]int{
+					0xff000000, 0xff000310, 0xff020716, 0xff040b1a, 0xff060e1e, 0xff091120, 0xff0b1323, 0xff0d1525,
+					0xff101727, 0xff12192a, 0xff141b2c, 0xff161d2e, 0xff181f30, 0xff1a2232, 0xff1c2434, 0xff1e2636,
+					0xff202838, 0xff222a3b, 0xff242c3d, 0xff262e3f, 0xff293041, 0xff2b3244, 0xff2d3546, 0xff2f3748,
+					0xff31394b, 0xff343b4d, 0xff363e4f, 0xff384052, 0xff3a4254, 0xff3d4456, 0xff3f4759, 0xff41495b,
+					0xff444b5e, 0xff464e60, 0xff485062, 0xff4b5265, 0xff4d5567, 0xff4f576a, 0xff52596c, 0xff545c6f,
+					0xff575e71, 0xff596174, 0xff5b6376, 0xff5e6679, 0xff60687b, 0xff636a7e, 0xff656d80, 0xff686f83,
+					0xff6a7286, 0xff6d7488, 0xff6f778b, 0xff72798d, 0xff747c90, 0xff777e93, 0xff798195, 0xff7c8498,
+					0xff7e869a, 0xff81899d, 0xff848ba0, 0xff868ea2, 0xff8991a5, 0xff8b93a8, 0xff8e96aa, 0xff9198ad,
+					0xff939bb0, 0xff969eb3, 0xff99a0b5, 0xff9ba3b8, 0xff9ea6bb, 0xffa1a8be, 0xffa3abc0, 0xffa6aec3,
+					0xffa9b0c6, 0xffabb3c9, 0xffaeb6cb, 0xffb1b8ce, 0xffb4bbd1, 0xffb6bed4, 0xffb9c1d7, 0xffbcc3d9,
+					0xffbfc6dc, 0xffc1c9df, 0xffc4cce2, 0xffc7cee5, 0xffcad1e8, 0xffcdd4ea, 0xffcfd7ed, 0xffd2daf0,
+					0xffd5dcf3, 0xffd8dff6, 0xffdbe2f9, 0xffdee5fc, 0xffe0e8ff, 0xffe4ebff, 0xffe9edff, 0xffedf0ff,
+					0xfff1f3ff, 0xfff5f6ff, 0xfff9f9ff, 0xfffefbff, 0xffffffff,
+				},
+			},
+			A3: ToneTable{
+				Hue:    325.97939535792614,
+				Chroma: 24.0,
+				Tones: [101]int{
+					0xff000000, 0xff0b0010, 0xff130118, 0xff17031c, 0xff1a051f, 0xff1d0822, 0xff200a24, 0xff230d27,
+					0xff250f29, 0xff27112b, 0xff29132d, 0xff2b152f, 0xff2e1731, 0xff301934, 0xff321b36, 0xff341d38,
+					0xff371f3a, 0xff39213c, 0xff3b233f, 0xff3e2641, 0xff402843, 0xff422a46, 0xff452c48, 0xff472e4a,
+					0xff49304d, 0xff4c334f, 0xff4e3551, 0xff513754, 0xff533956, 0xff553b58, 0xff583e5b, 0xff5a405d,
+					0xff5d4260, 0xff5f4562, 0xff624764, 0xff644967, 0xff674c69, 0xff694e6c, 0xff6c506e, 0xff6e5371,
+					0xff715573, 0xff735776, 0xff765a78, 0xff795c7b, 0xff7b5f7e, 0xff7e6180, 0xff806383, 0xff836685,
+					0xff866888, 0xff886b8a, 0xff8b6d8d, 0xff8e7090, 0xff907292, 0xff937595, 0xff967798, 0xff987a9a,
+					0xff9b7c9d, 0xff9e7fa0, 0xffa081a2, 0xffa384a5, 0xffa687a8, 0xffa989aa, 0xffab8cad, 0xffae8eb0,
+					0xffb191b2, 0xffb494b5, 0xffb696b8, 0xffb999bb, 0xffbc9bbd, 0xffbf9ec0, 0xffc2a1c3, 0xffc4a3c6,
+					0xffc7a6c8, 0xffcaa9cb, 0xffcdabce, 0xffd0aed1, 0xffd3b1d4, 0xffd6b3d6, 0xffd8b6d9, 0xffdbb9dc,
+					0xffdebcdf, 0xffe1bee2, 0xffe4c1e5, 0xffe7c4e7, 0xffeac7ea, 0xffedc9ed, 0xfff0ccf0, 0xfff3cff3,
+					0xfff5d2f6, 0xfff8d4f9, 0xfffbd7fc, 0xfffedaff, 0xffffdeff, 0xffffe2fe, 0xffffe7fd, 0xffffebfc,
+					0xffffeffc, 0xfffff3fb, 0xfffff7fa, 0xfffffbff, 0xffffffff,
+				},
+			},
+			N1: ToneTable{
+				Hue:    265.97939535792614,
+				Chroma: 4.0,
+				Tones: [101]int{
+					0xff000000, 0xff030405, 0xff06070a, 0xff0a0b0e, 0xff0d0e11, 0xff101114, 0xff121316, 0xff151518,
+					0xff17171b, 0xff19191d, 0xff1b1b1f, 0xff1d1d21, 0xff1f1f23, 0xff212125, 0xff232427, 0xff252629,
+					0xff27282b, 0xff292a2d, 0xff2b2c2f, 0xff2d2e31, 0xff303033, 0xff323236, 0xff343538, 0xff36373a,
+					0xff38393c, 0xff3b3b3f, 0xff3d3d41, 0xff3f4043, 0xff414245, 0xff444448, 0xff46464a, 0xff48494c,
+					0xff4b4b4f, 0xff4d4d51, 0xff4f5053, 0xff525256, 0xff545458, 0xff57575a, 0xff59595d, 0xff5b5c5f,
+					0xff5e5e62, 0xff606064, 0xff636367, 0xff656569, 0xff68686b, 0xff6a6a6e, 0xff6d6d70, 0xff6f6f73,
+					0xff727275, 0xff747478, 0xff77777a, 0xff79797d, 0xff7c7c7f, 0xff7e7e82, 0xff818185, 0xff848387,
+					0xff86868a, 0xff89888c, 0xff8b8b8f, 0xff8e8e91, 0xff919094, 0xff939397, 0xff969599, 0xff99989c,
+					0xff9b9b9f, 0xff9e9da1, 0xffa1a0a4, 0xffa3a3a7, 0xffa6a5a9, 0xffa9a8ac, 0xffababaf, 0xffaeadb1,
+					0xffb1b0b4, 0xffb4b3b7, 0xffb6b5b9, 0xffb9b8bc, 0xffbcbbbf, 0xffbfbec2, 0xffc1c0c4, 0xffc4c3c7,
+					0xffc7c6ca, 0xffcac9cd, 0xffcdcbcf, 0xffcfced2, 0xffd2d1d5, 0xffd5d4d8, 0xffd8d6db, 0xffdbd9dd,
+					0xffdedce0, 0xffe0dfe3, 0xffe3e2e6, 0xffe6e5e9, 0xffe9e7ec, 0xffeceaee, 0xffefedf1, 0xfff2f0f4,
+					0xfff5f3f7, 0xfff7f6fa, 0xfffaf9fd, 0xfffefbff, 0xffffffff,
+				},
+			},
+			N2: ToneTable{
+				Hue:    265.97939535792614,
+				Chroma: 8.0,
+				Tones: [101]int{
+					0xff000000, 0xff020408, 0xff05070d, 0xff080b11, 0xff0b0e15, 0xff0e1118, 0xff10131a, 0xff13161c,
+					0xff15181e, 0xff171a20, 0xff191b22, 0xff1b1d25, 0xff1d2027, 0xff1f2229, 0xff21242b, 0xff23262d,
+					0xff25282f, 0xff272a31, 0xff292c33, 0xff2b2e36, 0xff2e3038, 0xff30323a, 0xff32353c, 0xff34373e,
+					0xff363941, 0xff393b43, 0xff3b3d45, 0xff3d4048, 0xff3f424a, 0xff42444c, 0xff44474f, 0xff464951,
+					0xff494b53, 0xff4b4e56, 0xff4d5058, 0xff50525a, 0xff52555d, 0xff54575f, 0xff575962, 0xff595c64,
+					0xff5c5e66, 0xff5e6169, 0xff61636b, 0xff63656e, 0xff656870, 0xff686a73, 0xff6a6d75, 0xff6d6f78,
+					0xff6f727a, 0xff72747d, 0xff74777f, 0xff777982, 0xff7a7c85, 0xff7c7e87, 0xff7f818a, 0xff81838c,
+					0xff84868f, 0xff868992, 0xff898b94, 0xff8c8e97, 0xff8e9099, 0xff91939c, 0xff94969f, 0xff9698a1,
+					0xff999ba4, 0xff9c9da7, 0xff9ea0a9, 0xffa1a3ac, 0xffa4a5af, 0xffa6a8b1, 0xffa9abb4, 0xffacadb7,
+					0xffaeb0ba, 0xffb1b3bc, 0xffb4b6bf, 0xffb7b8c2, 0xffb9bbc5, 0xffbcbec7, 0xffbfc0ca, 0xffc2c3cd,
+					0xffc4c6d0, 0xffc7c9d2, 0xffcacbd5, 0xffcdced8, 0xffd0d1db, 0xffd2d4de, 0xffd5d7e0, 0xffd8d9e3,
+					0xffdbdce6, 0xffdedfe9, 0xffe1e2ec, 0xffe4e5ef, 0xffe6e8f2, 0xffe9eaf4, 0xffecedf7, 0xffeff0fa,
+					0xfff2f3fd, 0xfff5f6ff, 0xfff9f9ff, 0xfffefbff, 0xffffffff,
+				},
+			},
+			Error: ToneTable{
+				Hue:    25.0,
+				Chroma: 84.0,
+				Tones: [101]int{
+					0xff000000, 0xff100000, 0xff1a0000, 0xff210001, 0xff280001, 0xff2d0001, 0xff310001, 0xff360001,
+					0xff390001, 0xff3d0002, 0xff410002, 0xff450002, 0xff490002, 0xff4d0002, 0xff500003, 0xff540003,
+					0xff580003, 0xff5c0004, 0xff600004, 0xff640004, 0xff690005, 0xff6d0005, 0xff710005, 0xff750006,
+					0xff790006, 0xff7e0007, 0xff820007, 0xff860008, 0xff8a0008, 0xff8f0009, 0xff93000a, 0xff98000a,
+					0xff9c000b, 0xffa0000c, 0xffa4020d, 0xffa80710, 0xffac0c12, 0xffaf1014, 0xffb31416, 0xffb61718,
+					0xffba1a1a, 0xffbd1e1d, 0xffc1211f, 0xffc52421, 0xffc82623, 0xffcc2925, 0xffcf2c27, 0xffd32f29,
+					0xffd7322c, 0xffda342e, 0xffde3730, 0xffe13a32, 0xffe53c34, 0xffe93f36, 0xffec4139, 0xfff0443b,
+					0xfff3473d, 0xfff7493f, 0xfffb4c42, 0xfffe4e44, 0xffff5449, 0xffff5b4f, 0xffff6154, 0xffff665a,
+					0xffff6c5f, 0xffff7164, 0xffff7669, 0xffff7b6e, 0xffff8073, 0xffff8578, 0xffff897d, 0xffff8e82,
+					0xffff9286, 0xffff978b, 0xffff9b90, 0xffff9f94, 0xffffa399, 0xffffa89d, 0xffffaca2, 0xffffb0a6,
+					0xffffb4ab, 0xffffb8af, 0xffffbcb3, 0xffffbfb8, 0xffffc3bc, 0xffffc7c0, 0xffffcbc5, 0xffffcfc9,
+					0xffffd3cd, 0xffffd6d1, 0xffffdad6, 0xffffdeda, 0xffffe2de, 0xffffe5e2, 0xffffe9e6, 0xffffedea,
+					0xfffff0ee, 0xfffff4f2, 0xfffff8f7, 0xfffffbff, 0xffffffff,
+				},
+			},
+		},
+		// Red
+		0xffea4335: {
+			A1: ToneTable{
+				Hue:    26.28853590107876,
+				Chroma: 82.77797900786099,
+				Tones: [101]int{
+					0xff000000, 0xff100000, 0xff1a0000, 0xff220000, 0xff280000, 0xff2d0000, 0xff310000, 0xff360000,
+					0xff390001, 0xff3d0001, 0xff410001, 0xff450001, 0xff490001, 0xff4d0001, 0xff510001, 0xff540001,
+					0xff580001, 0xff5c0001, 0xff610002, 0xff650002, 0xff690002, 0xff6d0002, 0xff710002, 0xff750002,
+					0xff790003, 0xff7e0003, 0xff820003, 0xff860003, 0xff8b0004, 0xff8f0004, 0xff930004, 0xff980004,
+					0xff9c0005, 0xffa00407, 0xffa30809, 0xffa70d0b, 0xffaa110e, 0xffae1410, 0xffb21813, 0xffb51b15,
+					0xffb91e17, 0xffbc2119, 0xffc0241b, 0xffc3261e, 0xffc72920, 0xffcb2c22, 0xffce2f24, 0xffd23126,
+					0xffd53428, 0xffd9372b, 0xffdc392d, 0xffe03c2f, 0xffe43e31, 0xffe74133, 0xffeb4435, 0xffee4638,
+					0xfff2493a, 0xfff64b3c, 0xfff94e3e, 0xfffd5041, 0xffff5544, 0xffff5b4a, 0xffff6150, 0xffff6756,
+					0xffff6c5b, 0xffff7261, 0xffff7766, 0xffff7c6b, 0xffff8070, 0xffff8575, 0xffff8a7a, 0xffff8e7f,
+					0xffff9384, 0xffff9789, 0xffff9b8e, 0xffff9f92, 0xffffa497, 0xffffa89c, 0xffffaca0, 0xffffb0a5,
+					0xffffb4a9, 0xffffb8ae, 0xffffbcb2, 0xffffc0b7, 0xffffc3bb, 0xffffc7bf, 0xffffcbc4, 0xffffcfc8,
+					0xffffd3cc, 0xffffd6d1, 0xffffdad5, 0xffffded9, 0xffffe2dd, 0xffffe5e2, 0xffffe9e6, 0xffffedea,
+					0xfffff0ee, 0xfffff4f2, 0xfffff8f7, 0xfffffbff, 0xffffffff,
+				},
+			},
+			A2: ToneTable{
+				Hue:    26.28853590107876,
+				Chroma: 16.0,
+				Tones: [101]int{
+					0xff000000, 0xff0d0101, 0xff140302, 0xff190604, 0xff1c0806, 0xff200b08, 0xff230d0a, 0xff250f0c,
+					0xff28110e, 0xff2a1310, 0xff2c1512, 0xff2f1714, 0xff311916, 0xff331b18, 0xff361d1a, 0xff381f1c,
+					0xff3b211e, 0xff3d2320, 0xff3f2522, 0xff422724, 0xff442926, 0xff472c28, 0xff492e2a, 0xff4c302c,
+					0xff4e322e, 0xff513430, 0xff533632, 0xff563934, 0xff583b37, 0xff5b3d39, 0xff5d3f3b, 0xff60423d,
+					0xff62443f, 0xff654642, 0xff674844, 0xff6a4b46, 0xff6d4d49, 0xff6f4f4b, 0xff72524d, 0xff74544f,
+					0xff775652, 0xff7a5954, 0xff7c5b56, 0xff7f5e59, 0xff82605b, 0xff84625e, 0xff876560, 0xff8a6762,
+					0xff8c6a65, 0xff8f6c67, 0xff926f6a, 0xff95716c, 0xff97746e, 0xff9a7671, 0xff9d7973, 0xffa07b76,
+					0xffa27e78, 0xffa5807b, 0xffa8837d, 0xffab8580, 0xffae8882, 0xffb08a85, 0xffb38d87, 0xffb6908a,
+					0xffb9928d, 0xffbc958f, 0xffbe9792, 0xffc19a94, 0xffc49d97, 0xffc79f9a, 0xffcaa29c, 0xffcda59f,
+					0xffd0a7a1, 0xffd3aaa4, 0xffd5ada7, 0xffd8afa9, 0xffdbb2ac, 0xffdeb5af, 0xffe1b7b1, 0xffe4bab4,
+					0xffe7bdb7, 0xffeabfb9, 0xffedc2bc, 0xfff0c5bf, 0xfff3c8c2, 0xfff6cac4, 0xfff9cdc7, 0xfffcd0ca,
+					0xffffd3cc, 0xffffd6d1, 0xffffdad5, 0xffffded9, 0xffffe2dd, 0xffffe5e2, 0xffffe9e6, 0xffffedea,
+					0xfffff0ee, 0xfffff4f2, 0xfffff8f7, 0xfffffbff, 0xffffffff,
+				},
+			},
+			A3: ToneTable{
+				Hue:    86.28853590107876,
+				Chroma: 24.0,
+				Tones: [101]int{
+					0xff000000, 0xff060300, 0xff0c0700, 0xff110a00, 0xff150d00, 0xff181000, 0xff1b1200, 0xff1e1400,
+					0xff211600, 0xff231800, 0xff251a00, 0xff281c00, 0xff2a1e00, 0xff2d1f00, 0xff302100, 0xff322300,
+					0xff352500, 0xff372700, 0xff3a2a01, 0xff3c2c03, 0xff3e2e04, 0xff413006, 0xff433208, 0xff46340a,
+					0xff48370c, 0xff4a390e, 0xff4d3b10, 0xff4f3d12, 0xff524015, 0xff544217, 0xff574419, 0xff59461b,
+					0xff5c491d, 0xff5e4b1f, 0xff614d21, 0xff635023, 0xff665225, 0xff695428, 0xff6b572a, 0xff6e592c,
+					0xff705c2e, 0xff735e30, 0xff756032, 0xff786335, 0xff7b6537, 0xff7d6839, 0xff806a3b, 0xff836d3d,
+					0xff856f40, 0xff887242, 0xff8b7444, 0xff8d7746, 0xff907949, 0xff937c4b, 0xff957e4d, 0xff988150,
+					0xff9b8352, 0xff9e8654, 0xffa08956, 0xffa38b59, 0xffa68e5b, 0xffa9905e, 0xffab9360, 0xffae9662,
+					0xffb19865, 0xffb49b67, 0xffb79d69, 0xffb9a06c, 0xffbca36e, 0xffbfa571, 0xffc2a873, 0xffc5ab76,
+					0xffc8ad78, 0xffcab07b, 0xffcdb37d, 0xffd0b680, 0xffd3b882, 0xffd6bb85, 0xffd9be87, 0xffdcc18a,
+					0xffdfc38c, 0xffe2c68f, 0xffe5c991, 0xffe7cc94, 0xffeace96, 0xffedd199, 0xfff0d49b, 0xfff3d79e,
+					0xfff6daa1, 0xfff9dca3, 0xfffcdfa6, 0xffffe2a9, 0xffffe5b4, 0xffffe8bf, 0xffffecc9, 0xffffefd4,
+					0xfffff2de, 0xfffff5e8, 0xfffff8f2, 0xfffffbff, 0xffffffff,
+				},
+			},
+			N1: ToneTable{
+				Hue:    26.28853590107876,
+				Chroma: 4.0,
+				Tones: [101]int{
+					0xff000000, 0xff060303, 0xff0b0606, 0xff0f0a09, 0xff120d0c, 0xff15100f, 0xff181211, 0xff1a1413,
+					0xff1c1615, 0xff1e1817, 0xff201a19, 0xff231c1b, 0xff251e1d, 0xff27201f, 0xff292221, 0xff2b2423,
+					0xff2d2625, 0xff2f2827, 0xff322a29, 0xff342d2c, 0xff362f2e, 0xff383130, 0xff3b3332, 0xff3d3534,
+					0xff3f3736, 0xff413a38, 0xff443c3b, 0xff463e3d, 0xff48403f, 0xff4b4341, 0xff4d4544, 0xff4f4746,
+					0xff524948, 0xff544c4b, 0xff574e4d, 0xff59504f, 0xff5c5352, 0xff5e5554, 0xff605856, 0xff635a59,
+					0xff655c5b, 0xff685f5d, 0xff6a6160, 0xff6d6462, 0xff6f6665, 0xff726867, 0xff746b69, 0xff776d6c,
+					0xff7a706e, 0xff7c7271, 0xff7f7573, 0xff817776, 0xff847a78, 0xff877c7b, 0xff897f7d, 0xff8c8180,
+					0xff8e8482, 0xff918785, 0xff948988, 0xff968c8a, 0xff998e8d, 0xff9c918f, 0xff9e9492, 0xffa19694,
+					0xffa49997, 0xffa69b9a, 0xffa99e9c, 0xffaca19f, 0xffafa3a2, 0xffb1a6a4, 0xffb4a9a7, 0xffb7abaa,
+					0xffbaaeac, 0xffbcb1af, 0xffbfb3b2, 0xffc2b6b4, 0xffc5b9b7, 0xffc8bcba, 0xffcabebc, 0xffcdc1bf,
+					0xffd0c4c2, 0xffd3c7c5, 0xffd6c9c7, 0xffd9ccca, 0xffdbcfcd, 0xffded2d0, 0xffe1d4d2, 0xffe4d7d5,
+					0xffe7dad8, 0xffeadddb, 0xffede0de, 0xfff0e2e0, 0xfff3e5e3, 0xfff5e8e6, 0xfff8ebe9, 0xfffbeeec,
+					0xfffef1ef, 0xfffff4f2, 0xfffff8f7, 0xfffffbff, 0xffffffff,
+				},
+			},
+			N2: ToneTable{
+				Hue:    26.28853590107876,
+				Chroma: 8.0,
+				Tones: [101]int{
+					0xff000000, 0xff080202, 0xff0e0504, 0xff130807, 0xff160b0a, 0xff190e0d, 0xff1c110f, 0xff1e1311,
+					0xff201513, 0xff231715, 0xff251917, 0xff271b19, 0xff291d1b, 0xff2b1f1d, 0xff2e211f, 0xff302321,
+					0xff322523, 0xff342725, 0xff362927, 0xff392b29, 0xff3b2d2b, 0xff3d2f2d, 0xff40312f, 0xff423431,
+					0xff443634, 0xff473836, 0xff493a38, 0xff4b3c3a, 0xff4e3f3c, 0xff50413f, 0xff534341, 0xff554543,
+					0xff584845, 0xff5a4a48, 0xff5c4c4a, 0xff5f4f4c, 0xff61514f, 0xff645351, 0xff665653, 0xff695856,
+					0xff6b5a58, 0xff6e5d5a, 0xff715f5d, 0xff73625f, 0xff766462, 0xff786764, 0xff7b6966, 0xff7d6b69,
+					0xff806e6b, 0xff83706e, 0xff857370, 0xff887573, 0xff8b7875, 0xff8d7a78, 0xff907d7a, 0xff937f7d,
+					0xff95827f, 0xff988582, 0xff9b8784, 0xff9d8a87, 0xffa08c89, 0xffa38f8c, 0xffa5918f, 0xffa89491,
+					0xffab9794, 0xffae9996, 0xffb09c99, 0xffb39f9c, 0xffb6a19e, 0xffb9a4a1, 0xffbba7a3, 0xffbea9a6,
+					0xffc1aca9, 0xffc4afab, 0xffc7b1ae, 0xffcab4b1, 0xffccb7b3, 0xffcfb9b6, 0xffd2bcb9, 0xffd5bfbc,
+					0xffd8c2be, 0xffdbc4c1, 0xffddc7c4, 0xffe0cac7, 0xffe3cdc9, 0xffe6cfcc, 0xffe9d2cf, 0xffecd5d2,
+					0xffefd8d4, 0xfff2dbd7, 0xfff5ddda, 0xfff8e0dd, 0xfffbe3df, 0xfffee6e2, 0xffffe9e6, 0xffffedea,
+					0xfffff0ee, 0xfffff4f2, 0xfffff8f7, 0xfffffbff, 0xffffffff,
+				},
+			},
+			Error: ToneTable{
+				Hue:    25.0,
+				Chroma: 84.0,
+				Tones: [101]int{
+					0xff000000, 0xff100000, 0xff1a0000, 0xff210001, 0xff280001, 0xff2d0001, 0xff310001, 0xff360001,
+					0xff390001, 0xff3d0002, 0xff410002, 0xff450002, 0xff490002, 0xff4d0002, 0xff500003, 0xff540003,
+					0xff580003, 0xff5c0004, 0xff600004, 0xff640004, 0xff690005, 0xff6d0005, 0xff710005, 0xff750006,
+					0xff790006, 0xff7e0007, 0xff820007, 0xff860008, 0xff8a0008, 0xff8f0009, 0xff93000a, 0xff98000a,
+					0xff9c000b, 0xffa0000c, 0xffa4020d, 0xffa80710, 0xffac0c12, 0xffaf1014, 0xffb31416, 0xffb61718,
+					0xffba1a1a, 0xffbd1e1d, 0xffc1211f, 0xffc52421, 0xffc82623, 0xffcc2925, 0xffcf2c27, 0xffd32f29,
+					0xffd7322c, 0xffda342e, 0xffde3730, 0xffe13a32, 0xffe53c34, 0xffe93f36, 0xffec4139, 0xfff0443b,
+					0xfff3473d, 0xfff7493f, 0xfffb4c42, 0xfffe4e44, 0xffff5449, 0xffff5b4f, 0xffff6154, 0xffff665a,
+					0xffff6c5f, 0xffff7164, 0xffff7669, 0xffff7b6e, 0xffff8073, 0xffff8578, 0xffff897d, 0xffff8e82,
+					0xffff9286, 0xffff978b, 0xffff9b90, 0xffff9f94, 0xffffa399, 0xffffa89d, 0xffffaca2, 0xffffb0a6,
+					0xffffb4ab, 0xffffb8af, 0xffffbcb3, 0xffffbfb8, 0xffffc3bc, 0xffffc7c0, 0xffffcbc5, 0xffffcfc9,
+					0xffffd3cd, 0xffffd6d1, 0xffffdad6, 0xffffdeda, 0xffffe2de, 0xffffe5e2, 0xffffe9e6, 0xffffedea,
+					0xfffff0ee, 0xfffff4f2, 0xfffff8f7, 0xfffffbff, 0xffffffff,
+				},
+			},
+		},
+		// Yellow
+		0xfffbbc05: {
+			A1: ToneTable{
+				Hue:    85.15821691557672,
+				Chroma: 59.78367336730782,
+				Tones: [101]int{
+					0xff000000, 0xff060300, 0xff0c0700, 0xff110a00, 0xff150d00, 0xff190f00, 0xff1c1200, 0xff1e1400,
+					0xff211600, 0xff231800, 0xff261a00, 0xff281b00, 0xff2b1d00, 0xff2d1f00, 0xff302100, 0xff332300,
+					0xff352500, 0xff382700, 0xff3a2900, 0xff3d2b00, 0xff402d00, 0xff432f00, 0xff453200, 0xff483400,
+					0xff4b3600, 0xff4e3800, 0xff503a00, 0xff533c00, 0xff563e00, 0xff594000, 0xff5c4300, 0xff5f4500,
+					0xff624700, 0xff644900, 0xff674c00, 0xff6a4e00, 0xff6d5000, 0xff705200, 0xff735500, 0xff765700,
+					0xff795900, 0xff7c5b00, 0xff7f5e00, 0xff826000, 0xff856200, 0xff896500, 0xff8c6700, 0xff8f6900,
+					0xff926c00, 0xff956e00, 0xff987100, 0xff9b7300, 0xff9e7500, 0xffa27800, 0xffa57a00, 0xffa87d00,
+					0xffab7f00, 0xffaf8200, 0xffb28400, 0xffb58700, 0xffb88900, 0xffbc8c00, 0xffbf8e00, 0xffc29100,
+					0xffc59300, 0xffc99600, 0xffcc9800, 0xffcf9b00, 0xffd39d00, 0xffd6a000, 0xffd9a200, 0xffdda500,
+					0xffe0a700, 0xffe4aa00, 0xffe7ad00, 0xffeaaf00, 0xffeeb200, 0xfff1b400, 0xfff5b700, 0xfff8ba00,
+					0xfffbbc06, 0xfffebf0d, 0xffffc32d, 0xffffc642, 0xffffca53, 0xffffcd62, 0xffffd170, 0xffffd47d,
+					0xffffd889, 0xffffdb95, 0xffffdea0, 0xffffe2ab, 0xffffe5b6, 0xffffe8c0, 0xffffeccb, 0xffffefd5,
+					0xfffff2df, 0xfffff5e8, 0xfffff8f2, 0xfffffbff, 0xffffffff,
+				},
+			},
+			A2: ToneTable{
+				Hue:    85.15821691557672,
+				Chroma: 16.0,
+				Tones: [101]int{
+					0xff000000, 0xff060300, 0xff0c0700, 0xff110a00, 0xff150d00, 0xff190f00, 0xff1b1200, 0xff1e1401,
+					0xff201602, 0xff221803, 0xff241a04, 0xff271c05, 0xff291e06, 0xff2b2008, 0xff2d220a, 0xff2f240c,
+					0xff32260e, 0xff342810, 0xff362a11, 0xff382d13, 0xff3b2f15, 0xff3d3117, 0xff3f3319, 0xff42351b,
+					0xff44371d, 0xff473a1f, 0xff493c21, 0xff4b3e23, 0xff4e4025, 0xff504328, 0xff53452a, 0xff55472c,
+					0xff574a2e, 0xff5a4c30, 0xff5c4e32, 0xff5f5134, 0xff615336, 0xff645539, 0xff66583b, 0xff695a3d,
+					0xff6c5c3f, 0xff6e5f41, 0xff716144, 0xff736446, 0xff766648, 0xff78694a, 0xff7b6b4d, 0xff7e6e4f,
+					0xff807051, 0xff837354, 0xff857556, 0xff887858, 0xff8b7a5b, 0xff8d7d5d, 0xff907f5f, 0xff938262,
+					0xff958464, 0xff988767, 0xff9b8969, 0xff9d8c6b, 0xffa08f6e, 0xffa39170, 0xffa69473, 0xffa89675,
+					0xffab9978, 0xffae9c7a, 0xffb19e7d, 0xffb3a17f, 0xffb6a482, 0xffb9a684, 0xffbca987, 0xffbfac89,
+					0xffc1ae8c, 0xffc4b18e, 0xffc7b491, 0xffcab693, 0xffcdb996, 0xffd0bc99, 0xffd2bf9b, 0xffd5c19e,
+					0xffd8c4a0, 0xffdbc7a3, 0xffdecaa6, 0xffe1cca8, 0xffe4cfab, 0xffe7d2ad, 0xffe9d5b0, 0xffecd8b3,
+					0xffefdab5, 0xfff2ddb8, 0xfff5e0bb, 0xfff8e3bd, 0xfffbe6c0, 0xfffee8c3, 0xffffeccb, 0xffffefd5,
+					0xfffff2df, 0xfffff5e8, 0xfffff8f2, 0xfffffbff, 0xffffffff,
+				},
+			},
+			A3: ToneTable{
+				Hue:    145.1582169155767,
+				Chroma: 24.0,
+				Tones: [101]int{
+					0xff000000, 0xff000500, 0xff000a01, 0xff000f01, 0xff001201, 0xff001502, 0xff021803, 0xff031a04,
+					0xff051c05, 0xff061e07, 0xff082008, 0xff0a230a, 0xff0c250c, 0xff0e270e, 0xff112910, 0xff132b11,
+					0xff152d13, 0xff172f15, 0xff193217, 0xff1b3419, 0xff1d361b, 0xff20381d, 0xff223b1f, 0xff243d21,
+					0xff263f23, 0xff284126, 0xff2a4428, 0xff2d462a, 0xff2f482c, 0xff314b2e, 0xff334d30, 0xff364f32,
+					0xff385234, 0xff3a5437, 0xff3d5739, 0xff3f593b, 0xff415b3d, 0xff435e3f, 0xff466042, 0xff486344,
+					0xff4b6546, 0xff4d6848, 0xff4f6a4b, 0xff526d4d, 0xff546f4f, 0xff577252, 0xff597454, 0xff5b7756,
+					0xff5e7959, 0xff607c5b, 0xff637e5d, 0xff658160, 0xff688362, 0xff6a8665, 0xff6d8967, 0xff6f8b69,
+					0xff728e6c, 0xff74906e, 0xff779371, 0xff799673, 0xff7c9876, 0xff7e9b78, 0xff819e7b, 0xff84a07d,
+					0xff86a380, 0xff89a682, 0xff8ba885, 0xff8eab87, 0xff91ae8a, 0xff93b08c, 0xff96b38f, 0xff99b691,
+					0xff9bb994, 0xff9ebb97, 0xffa1be99, 0xffa3c19c, 0xffa6c49e, 0xffa9c6a1, 0xffabc9a4, 0xffaecca6,
+					0xffb1cfa9, 0xffb3d2ab, 0xffb6d4ae, 0xffb9d7b1, 0xffbcdab3, 0xffbeddb6, 0xffc1e0b9, 0xffc4e3bc,
+					0xffc7e5be, 0xffcae8c1, 0xffccebc4, 0xffcfeec6, 0xffd2f1c9, 0xffd5f4cc, 0xffd8f7cf, 0xffdaf9d1,
+					0xffddfcd4, 0xffe1ffd8, 0xffecffe4, 0xfff6ffef, 0xffffffff,
+				},
+			},
+			N1: ToneTable{
+				Hue:    85.15821691557672,
+				Chroma: 4.0,
+				Tones: [101]int{
+					0xff000000, 0xff050402, 0xff090704, 0xff0d0b06, 0xff100e09, 0xff13110c, 0xff16130e, 0xff181510,
+					0xff1a1712, 0xff1c1914, 0xff1e1b16, 0xff201d18, 0xff221f1a, 0xff24211c, 0xff27231e, 0xff292520,
+					0xff2b2722, 0xff2d2924, 0xff2f2c26, 0xff312e28, 0xff34302a, 0xff36322c, 0xff38342e, 0xff3a3630,
+					0xff3d3933, 0xff3f3b35, 0xff413d37, 0xff433f39, 0xff46423b, 0xff48443e, 0xff4a4640, 0xff4d4842,
+					0xff4f4b44, 0xff524d47, 0xff544f49, 0xff56524b, 0xff59544e, 0xff5b5650, 0xff5e5952, 0xff605b55,
+					0xff635e57, 0xff656059, 0xff67625c, 0xff6a655e, 0xff6c6760, 0xff6f6a63, 0xff716c65, 0xff746f68,
+					0xff77716a, 0xff79746d, 0xff7c766f, 0xff7e7972, 0xff817b74, 0xff837e76, 0xff868079, 0xff89837b,
+					0xff8b857e, 0xff8e8881, 0xff918b83, 0xff938d86, 0xff969088, 0xff98928b, 0xff9b958d, 0xff9e9890,
+					0xffa19a92, 0xffa39d95, 0xffa6a098, 0xffa9a29a, 0xffaba59d, 0xffaea7a0, 0xffb1aaa2, 0xffb4ada5,
+					0xffb6b0a7, 0xffb9b2aa, 0xffbcb5ad, 0xffbfb8af, 0xffc1bab2, 0xffc4bdb5, 0xffc7c0b8, 0xffcac3ba,
+					0xffcdc5bd, 0xffcfc8c0, 0xffd2cbc2, 0xffd5cec5, 0xffd8d0c8, 0xffdbd3cb, 0xffded6cd, 0xffe0d9d0,
+					0xffe3dcd3, 0xffe6ded6, 0xffe9e1d8, 0xffece4db, 0xffefe7de, 0xfff2eae1, 0xfff5ede4, 0xfff8efe7,
+					0xfffaf2e9, 0xfffdf5ec, 0xfffff8f2, 0xfffffbff, 0xffffffff,
+				},
+			},
+			N2: ToneTable{
+				Hue:    85.15821691557672,
+				Chroma: 8.0,
+				Tones: [101]int{
+					0xff000000, 0xff060300, 0xff0b0701, 0xff0f0a03, 0xff120e05, 0xff151006, 0xff181308, 0xff1a150a,
+					0xff1c170c, 0xff1e190e, 0xff201b10, 0xff231d12, 0xff251f14, 0xff272116, 0xff292318, 0xff2b2519,
+					0xff2d271b, 0xff2f291d, 0xff322b1f, 0xff342d21, 0xff363024, 0xff383226, 0xff3b3428, 0xff3d362a,
+					0xff3f382c, 0xff423b2e, 0xff443d30, 0xff463f32, 0xff494134, 0xff4b4437, 0xff4d4639, 0xff50483b,
+					0xff524a3d, 0xff544d3f, 0xff574f42, 0xff595144, 0xff5c5446, 0xff5e5648, 0xff61594b, 0xff635b4d,
+					0xff665d4f, 0xff686052, 0xff6b6254, 0xff6d6556, 0xff706759, 0xff72695b, 0xff756c5d, 0xff776e60,
+					0xff7a7162, 0xff7c7365, 0xff7f7667, 0xff827869, 0xff847b6c, 0xff877d6e, 0xff898071, 0xff8c8373,
+					0xff8f8576, 0xff918878, 0xff948a7b, 0xff978d7d, 0xff998f80, 0xff9c9282, 0xff9f9585, 0xffa19787,
+					0xffa49a8a, 0xffa79d8c, 0xffaa9f8f, 0xffaca292, 0xffafa494, 0xffb2a797, 0xffb4aa99, 0xffb7ac9c,
+					0xffbaaf9f, 0xffbdb2a1, 0xffc0b5a4, 0xffc2b7a6, 0xffc5baa9, 0xffc8bdac, 0xffcbbfae, 0xffcec2b1,
+					0xffd0c5b4, 0xffd3c8b6, 0xffd6cbb9, 0xffd9cdbc, 0xffdcd0bf, 0xffdfd3c1, 0xffe2d6c4, 0xffe4d8c7,
+					0xffe7dbc9, 0xffeadecc, 0xffede1cf, 0xfff0e4d2, 0xfff3e7d4, 0xfff6e9d7, 0xfff9ecda, 0xfffcefdd,
+					0xfffff2e0, 0xfffff5e8, 0xfffff8f2, 0xfffffbff, 0xffffffff,
+				},
+			},
+			Error: ToneTable{
+				Hue:    25.0,
+				Chroma: 84.0,
+				Tones: [101]int{
+					0xff000000, 0xff100000, 0xff1a0000, 0xff210001, 0xff280001, 0xff2d0001, 0xff310001, 0xff360001,
+					0xff390001, 0xff3d0002, 0xff410002, 0xff450002, 0xff490002, 0xff4d0002, 0xff500003, 0xff540003,
+					0xff580003, 0xff5c0004, 0xff600004, 0xff640004, 0xff690005, 0xff6d0005, 0xff710005, 0xff750006,
+					0xff790006, 0xff7e0007, 0xff820007, 0xff860008, 0xff8a0008, 0xff8f0009, 0xff93000a, 0xff98000a,
+					0xff9c000b, 0xffa0000c, 0xffa4020d, 0xffa80710, 0xffac0c12, 0xffaf1014, 0xffb31416, 0xffb61718,
+					0xffba1a1a, 0xffbd1e1d, 0xffc1211f, 0xffc52421, 0xffc82623, 0xffcc2925, 0xffcf2c27, 0xffd32f29,
+					0xffd7322c, 0xffda342e, 0xffde3730, 0xffe13a32, 0xffe53c34, 0xffe93f36, 0xffec4139, 0xfff0443b,
+					0xfff3473d, 0xfff7493f, 0xfffb4c42, 0xfffe4e44, 0xffff5449, 0xffff5b4f, 0xffff6154, 0xffff665a,
+					0xffff6c5f, 0xffff7164, 0xffff7669, 0xffff7b6e, 0xffff8073, 0xffff8578, 0xffff897d, 0xffff8e82,
+					0xffff9286, 0xffff978b, 0xffff9b90, 0xffff9f94, 0xffffa399, 0xffffa89d, 0xffffaca2, 0xffffb0a6,
+					0xffffb4ab, 0xffffb8af, 0xffffbcb3, 0xffffbfb8, 0xffffc3bc, 0xffffc7c0, 0xffffcbc5, 0xffffcfc9,
+					0xffffd3cd, 0xffffd6d1, 0xffffdad6, 0xffffdeda, 0xffffe2de, 0xffffe5e2, 0xffffe9e6, 0xffffedea,
+					0xfffff0ee, 0xfffff4f2, 0xfffff8f7, 0xfffffbff, 0xffffffff,
+				},
+			},
+		},
+		// Green
+		0xff34a853: {
+			A1: ToneTable{
+				Hue:    150.24025427898678,
+				Chroma: 60.662876464401435,
+				Tones: [101]int{
+					0xff000000, 0xff000501, 0xff000a02, 0xff000e02, 0xff001203, 0xff001504, 0xff001805, 0xff001b06,
+					0xff001d07, 0xff001f07, 0xff002108, 0xff002409, 0xff00260a, 0xff00280c, 0xff002b0d, 0xff002d0e,
+					0xff002f0f, 0xff003210, 0xff003411, 0xff003712, 0xff003914, 0xff003c15, 0xff003e16, 0xff004117,
+					0xff004318, 0xff004619, 0xff00481b, 0xff004b1c, 0xff004d1d, 0xff00501e, 0xff005320, 0xff005521,
+					0xff005822, 0xff005b23, 0xff005d25, 0xff006026, 0xff006327, 0xff006528, 0xff00682a, 0xff006b2b,
+					0xff006e2c, 0xff00702e, 0xff00732f, 0xff007630, 0xff007932, 0xff007c33, 0xff007e34, 0xff008136,
+					0xff008437, 0xff008738, 0xff008a3a, 0xff048d3b, 0xff0c8f3e, 0xff139240, 0xff189542, 0xff1d9745,
+					0xff219a47, 0xff259d49, 0xff29a04c, 0xff2da24e, 0xff30a550, 0xff34a853, 0xff37aa55, 0xff3aad58,
+					0xff3db05a, 0xff41b35c, 0xff44b65f, 0xff47b861, 0xff4abb64, 0xff4dbe66, 0xff50c168, 0xff53c46b,
+					0xff56c66d, 0xff58c970, 0xff5bcc72, 0xff5ecf75, 0xff61d277, 0xff64d57a, 0xff67d87c, 0xff6ada7f,
+					0xff6ddd81, 0xff6fe084, 0xff72e386, 0xff75e689, 0xff78e98b, 0xff7bec8e, 0xff7eef90, 0xff80f293,
+					0xff83f595, 0xff86f898, 0xff89fa9b, 0xff8cfd9d, 0xff96ffa4, 0xffa8ffb0, 0xffb7ffbc, 0xffc6ffc7,
+					0xffd3ffd2, 0xffdfffdd, 0xffebffe7, 0xfff6fff1, 0xffffffff,
+				},
+			},
+			A2: ToneTable{
+				Hue:    150.24025427898678,
+				Chroma: 16.0,
+				Tones: [101]int{
+					0xff000000, 0xff000501, 0xff000a02, 0xff020e03, 0xff031105, 0xff051407, 0xff071709, 0xff09190b,
+					0xff0b1b0d, 0xff0d1d0f, 0xff0f1f11, 0xff112113, 0xff132315, 0xff152517, 0xff172719, 0xff192a1b,
+					0xff1b2c1d, 0xff1d2e1f, 0xff1f3021, 0xff223223, 0xff243425, 0xff263727, 0xff283929, 0xff2a3b2b,
+					0xff2c3d2d, 0xff2f402f, 0xff314231, 0xff334433, 0xff354636, 0xff374938, 0xff3a4b3a, 0xff3c4d3c,
+					0xff3e503e, 0xff415241, 0xff435443, 0xff455745, 0xff485947, 0xff4a5c4a, 0xff4c5e4c, 0xff4f614e,
+					0xff516351, 0xff536553, 0xff566855, 0xff586a58, 0xff5b6d5a, 0xff5d6f5c, 0xff5f725f, 0xff627461,
+					0xff647764, 0xff677966, 0xff697c68, 0xff6c7e6b, 0xff6e816d, 0xff718470, 0xff738672, 0xff768975,
+					0xff788b77, 0xff7b8e7a, 0xff7e917c, 0xff80937f, 0xff839681, 0xff859884, 0xff889b86, 0xff8a9e89,
+					0xff8da08b, 0xff90a38e, 0xff92a691, 0xff95a893, 0xff98ab96, 0xff9aae98, 0xff9db09b, 0xffa0b39e,
+					0xffa2b6a0, 0xffa5b9a3, 0xffa8bba5, 0xffaabea8, 0xffadc1ab, 0xffb0c4ad, 0xffb3c6b0, 0xffb5c9b3,
+					0xffb8ccb5, 0xffbbcfb8, 0xffbdd1bb, 0xffc0d4be, 0xffc3d7c0, 0xffc6dac3, 0xffc9ddc6, 0xffcbe0c8,
+					0xffcee2cb, 0xffd1e5ce, 0xffd4e8d1, 0xffd7ebd4, 0xffd9eed6, 0xffdcf1d9, 0xffdff4dc, 0xffe2f6df,
+					0xffe5f9e1, 0xffe8fce4, 0xffebffe7, 0xfff6fff1, 0xffffffff,
+				},
+			},
+			A3: ToneTable{
+				Hue:    210.24025427898678,
+				Chroma: 24.0,
+				Tones: [101]int{
+					0xff000000, 0xff000506, 0xff00090b, 0xff000d10, 0xff001114, 0xff001417, 0xff00161a, 0xff00191d,
+					0xff001b1f, 0xff001d22, 0xff001f24, 0xff002226, 0xff002429, 0xff00262b, 0xff00282e, 0xff002b30,
+					0xff002d33, 0xff002f35, 0xff003138, 0xff00343a, 0xff00363d, 0xff03383f, 0xff063b42, 0xff0a3d44,
+					0xff0d3f46, 0xff114148, 0xff14444b, 0xff17464d, 0xff1a484f, 0xff1d4b52, 0xff1f4d54, 0xff224f57,
+					0xff255259, 0xff27545b, 0xff2a565e, 0xff2c5960, 0xff2f5b63, 0xff315e65, 0xff346067, 0xff36626a,
+					0xff39656c, 0xff3c676f, 0xff3e6a71, 0xff416c74, 0xff436f76, 0xff467179, 0xff48747b, 0xff4b767e,
+					0xff4d7981, 0xff507b83, 0xff527e86, 0xff558088, 0xff57838b, 0xff5a868d, 0xff5c8890, 0xff5f8b93,
+					0xff628d95, 0xff649098, 0xff67939b, 0xff69959d, 0xff6c98a0, 0xff6f9aa2, 0xff719da5, 0xff74a0a8,
+					0xff76a2ab, 0xff79a5ad, 0xff7ca8b0, 0xff7eabb3, 0xff81adb5, 0xff84b0b8, 0xff86b3bb, 0xff89b5be,
+					0xff8bb8c0, 0xff8ebbc3, 0xff91bec6, 0xff94c0c9, 0xff96c3cb, 0xff99c6ce, 0xff9cc9d1, 0xff9ecbd4,
+					0xffa1ced7, 0xffa4d1d9, 0xffa7d4dc, 0xffa9d7df, 0xffacd9e2, 0xffafdce5, 0xffb2dfe8, 0xffb4e2ea,
+					0xffb7e5ed, 0xffbae8f0, 0xffbdeaf3, 0xffbfedf6, 0xffc2f0f9, 0xffc5f3fc, 0xffc8f6ff, 0xffd1f8ff,
+					0xffdaf9ff, 0xffe4fbff, 0xffedfcff, 0xfff6feff, 0xffffffff,
+				},
+			},
+			N1: ToneTable{
+				Hue:    150.24025427898678,
+				Chroma: 4.0,
+				Tones: [101]int{
+					0xff000000, 0xff030403, 0xff060806, 0xff090c09, 0xff0c0f0c, 0xff0f120f, 0xff111411, 0xff141613,
+					0xff161815, 0xff181a17, 0xff1a1c19, 0xff1c1e1b, 0xff1e201d, 0xff20221f, 0xff222421, 0xff242623,
+					0xff262925, 0xff282b27, 0xff2a2d29, 0xff2c2f2b, 0xff2e312d, 0xff313330, 0xff333532, 0xff353834,
+					0xff373a36, 0xff3a3c38, 0xff3c3e3b, 0xff3e413d, 0xff40433f, 0xff434541, 0xff454743, 0xff474a46,
+					0xff4a4c48, 0xff4c4e4a, 0xff4e514d, 0xff51534f, 0xff535551, 0xff555854, 0xff585a56, 0xff5a5d58,
+					0xff5d5f5b, 0xff5f615d, 0xff626460, 0xff646662, 0xff666964, 0xff696b67, 0xff6b6e69, 0xff6e706c,
+					0xff70736e, 0xff737571, 0xff767873, 0xff787a76, 0xff7b7d78, 0xff7d7f7b, 0xff80827d, 0xff828480,
+					0xff858782, 0xff878a85, 0xff8a8c87, 0xff8d8f8a, 0xff8f918c, 0xff92948f, 0xff959792, 0xff979994,
+					0xff9a9c97, 0xff9d9e99, 0xff9fa19c, 0xffa2a49f, 0xffa5a6a1, 0xffa7a9a4, 0xffaaaca7, 0xffadaea9,
+					0xffafb1ac, 0xffb2b4af, 0xffb5b7b1, 0xffb8b9b4, 0xffbabcb7, 0xffbdbfb9, 0xffc0c2bc, 0xffc3c4bf,
+					0xffc6c7c2, 0xffc8cac4, 0xffcbcdc7, 0xffcecfca, 0xffd1d2cd, 0xffd4d5cf, 0xffd6d8d2, 0xffd9dbd5,
+					0xffdcddd8, 0xffdfe0db, 0xffe2e3dd, 0xffe5e6e0, 0xffe8e9e3, 0xffeaece6, 0xffedeee9, 0xfff0f1eb,
+					0xfff3f4ee, 0xfff6f7f1, 0xfff9faf4, 0xfffcfdf7, 0xffffffff,
+				},
+			},
+			N2: ToneTable{
+				Hue:    150.24025427898678,
+				Chroma: 8.0,
+				Tones: [101]int{
+					0xff000000, 0xff010502, 0xff040904, 0xff070d07, 0xff09100a, 0xff0c130c, 0xff0e150f, 0xff111711,
+					0xff131913, 0xff151b15, 0xff171d16, 0xff191f18, 0xff1b211a, 0xff1d231c, 0xff1f251e, 0xff212720,
+					0xff232a22, 0xff252c24, 0xff272e26, 0xff293029, 0xff2b322b, 0xff2d342d, 0xff30372f, 0xff323931,
+					0xff343b33, 0xff363d35, 0xff384038, 0xff3b423a, 0xff3d443c, 0xff3f463e, 0xff424940, 0xff444b43,
+					0xff464d45, 0xff485047, 0xff4b524a, 0xff4d544c, 0xff50574e, 0xff525951, 0xff545c53, 0xff575e55,
+					0xff596058, 0xff5c635a, 0xff5e655c, 0xff60685f, 0xff636a61, 0xff656d64, 0xff686f66, 0xff6a7268,
+					0xff6d746b, 0xff6f776d, 0xff727970, 0xff747c72, 0xff777e75, 0xff798177, 0xff7c837a, 0xff7e867c,
+					0xff81887f, 0xff848b81, 0xff868e84, 0xff899086, 0xff8b9389, 0xff8e958b, 0xff91988e, 0xff939b91,
+					0xff969d93, 0xff99a096, 0xff9ba398, 0xff9ea59b, 0xffa1a89e, 0xffa3aba0, 0xffa6ada3, 0xffa9b0a6,
+					0xffabb3a8, 0xffaeb5ab, 0xffb1b8ae, 0xffb4bbb0, 0xffb6beb3, 0xffb9c0b6, 0xffbcc3b8, 0xffbfc6bb,
+					0xffc1c9be, 0xffc4cbc1, 0xffc7cec3, 0xffcad1c6, 0xffccd4c9, 0xffcfd7cb, 0xffd2d9ce, 0xffd5dcd1,
+					0xffd8dfd4, 0xffdbe2d7, 0xffdde5d9, 0xffe0e7dc, 0xffe3eadf, 0xffe6ede2, 0xffe9f0e5, 0xffecf3e7,
+					0xffeff6ea, 0xfff2f9ed, 0xfff4fcf0, 0xfff7fef3, 0xffffffff,
+				},
+			},
+			Error: ToneTable{
+				Hue:    25.0,
+				Chroma: 84.0,
+				Tones: [101]int{
+					0xff000000, 0xff100000, 0xff1a0000, 0xff210001, 0xff280001, 0xff2d0001, 0xff310001, 0xff360001,
+					0xff390001, 0xff3d0002, 0xff410002, 0xff450002, 0xff490002, 0xff4d0002, 0xff500003, 0xff540003,
+					0xff580003, 0xff5c0004, 0xff600004, 0xff640004, 0xff690005, 0xff6d0005, 0xff710005, 0xff750006,
+					0xff790006, 0xff7e0007, 0xff820007, 0xff860008, 0xff8a0008, 0xff8f0009, 0xff93000a, 0xff98000a,
+					0xff9c000b, 0xffa0000c, 0xffa4020d, 0xffa80710, 0xffac0c12, 0xffaf1014, 0xffb31416, 0xffb61718,
+					0xffba1a1a, 0xffbd1e1d, 0xffc1211f, 0xffc52421, 0xffc82623, 0xffcc2925, 0xffcf2c27, 0xffd32f29,
+					0xffd7322c, 0xffda342e, 0xffde3730, 0xffe13a32, 0xffe53c34, 0xffe93f36, 0xffec4139, 0xfff0443b,
+					0xfff3473d, 0xfff7493f, 0xfffb4c42, 0xfffe4e44, 0xffff5449, 0xffff5b4f, 0xffff6154, 0xffff665a,
+					0xffff6c5f, 0xffff7164, 0xffff7669, 0xffff7b6e, 0xffff8073, 0xffff8578, 0xffff897d, 0xffff8e82,
+					0xffff9286, 0xffff978b, 0xffff9b90, 0xffff9f94, 0xffffa399, 0xffffa89d, 0xffffaca2, 0xffffb0a6,
+					0xffffb4ab, 0xffffb8af, 0xffffbcb3, 0xffffbfb8, 0xffffc3bc, 0xffffc7c0, 0xffffcbc5, 0xffffcfc9,
+					0xffffd3cd, 0xffffd6d1, 0xffffdad6, 0xffffdeda, 0xffffe2de, 0xffffe5e2, 0xffffe9e6, 0xffffedea,
+					0xfffff0ee, 0xfffff4f2, 0xfffff8f7, 0xfffffbff, 0xffffffff,
+				},
+			},
+		},
+	}
+	contentSeedToneTables = map[int]*CoreToneTable{
+		// Baseline
+		0xff6750a4: {
+			A1: ToneTable{
+				Hue:    298.98099721070395,
+				Chroma: 47.856526374970336,
+				Tones: [101]int{
+					0xff000000, 0xff05001b, 0xff0a0028, 0xff0f0032, 0xff13003a, 0xff160041, 0xff190048, 0xff1b004d,
+					0xff1d0053, 0xff200058, 0xff22005d, 0xff24025f, 0xff260661, 0xff280963, 0xff2a0d65, 0xff2d1067,
+					0xff2f1369, 0xff31166b, 0xff33196e, 0xff351c70, 0xff381e72, 0xff3a2174, 0xff3c2377, 0xff3e2679,
+					0xff41287b, 0xff432b7e, 0xff452d80, 0xff483083, 0xff4a3285, 0xff4c3588, 0xff4f378a, 0xff513a8d,
+					0xff533c8f, 0xff563f92, 0xff584194, 0xff5b4397, 0xff5d4699, 0xff5f489c, 0xff624b9f, 0xff644da1,
+					0xff6750a4, 0xff6952a6, 0xff6c55a9, 0xff6e57ac, 0xff715aae, 0xff735cb1, 0xff765fb4, 0xff7861b7,
+					0xff7b64b9, 0xff7e66bc, 0xff8069bf, 0xff836bc1, 0xff856ec4, 0xff8870c7, 0xff8b73ca, 0xff8d76cd,
+					0xff9078cf, 0xff927bd2, 0xff957dd5, 0xff9880d8, 0xff9a83db, 0xff9d85de, 0xffa088e0, 0xffa28ae3,
+					0xffa58de6, 0xffa890e9, 0xffab92ec, 0xffad95ef, 0xffb098f2, 0xffb39af5, 0xffb69df7, 0xffb8a0fa,
+					0xffbba2fd, 0xffbea5ff, 0xffc0a9ff, 0xffc3acff, 0xffc5afff, 0xffc8b3ff, 0xffcab6ff, 0xffcdb9ff,
+					0xffcfbcff, 0xffd2c0ff, 0xffd4c3ff, 0xffd7c6ff, 0xffd9caff, 0xffdccdff, 0xffded0ff, 0xffe1d3ff,
+					0xffe4d7ff, 0xffe6daff, 0xffe9ddff, 0xffebe0ff, 0xffeee4ff, 0xfff0e7ff, 0xfff3eaff, 0xfff6eeff,
+					0xfff8f1ff, 0xfffbf4ff, 0xfffdf7ff, 0xfffffbff, 0xffffffff,
+				},
+			},
+			A2: ToneTable{
+				Hue:    298.98099721070395,
+				Chroma: 15.952175458323445,
+				Tones: [101]int{
+					0xff000000, 0xff050210, 0xff090515, 0xff0d0819, 0xff100b1d, 0xff130e20, 0xff151022, 0xff181325,
+					0xff1a1527, 0xff1c1729, 0xff1e192b, 0xff201b2d, 0xff221d2f, 0xff241f31, 0xff262133, 0xff282336,
+					0xff2a2538, 0xff2c273a, 0xff2e293c, 0xff312b3e, 0xff332d41, 0xff353043, 0xff373245, 0xff3a3448,
+					0xff3c364a, 0xff3e384c, 0xff403b4f, 0xff433d51, 0xff453f53, 0xff474156, 0xff4a4458, 0xff4c465a,
+					0xff4e485d, 0xff514b5f, 0xff534d62, 0xff554f64, 0xff585267, 0xff5a5469, 0xff5d566b, 0xff5f596e,
+					0xff625b70, 0xff645d73, 0xff676075, 0xff696278, 0xff6c657b, 0xff6e677d, 0xff716a80, 0xff736c82,
+					0xff766f85, 0xff787187, 0xff7b748a, 0xff7d768d, 0xff80798f, 0xff827b92, 0xff857e94, 0xff888097,
+					0xff8a839a, 0xff8d859c, 0xff90889f, 0xff928aa2, 0xff958da4, 0xff9790a7, 0xff9a92aa, 0xff9d95ac,
+					0xff9f97af, 0xffa29ab2, 0xffa59db5, 0xffa89fb7, 0xffaaa2ba, 0xffada5bd, 0xffb0a7bf, 0xffb2aac2,
+					0xffb5adc5, 0xffb8afc8, 0xffbbb2cb, 0xffbeb5cd, 0xffc0b8d0, 0xffc3bad3, 0xffc6bdd6, 0xffc9c0d9,
+					0xffcbc2db, 0xffcec5de, 0xffd1c8e1, 0xffd4cbe4, 0xffd7cde7, 0xffdad0ea, 0xffdcd3ec, 0xffdfd6ef,
+					0xffe2d9f2, 0xffe5dbf5, 0xffe8def8, 0xffebe1fb, 0xffeee4fe, 0xfff0e7ff, 0xfff3eaff, 0xfff6eeff,
+					0xfff8f1ff, 0xfffbf4ff, 0xfffdf7ff, 0xfffffbff, 0xffffffff,
+				},
+			},
+			A3: ToneTable{
+				Hue:    358.98099721070395,
+				Chroma: 23.928263187485168,
+				Tones: [101]int{
+					0xff000000, 0xff0f0004, 0xff170108, 0xff1c020c, 0xff210410, 0xff240612, 0xff270815, 0xff2a0a17,
+					0xff2c0d19, 0xff2f0f1b, 0xff31111d, 0xff33131f, 0xff361521, 0xff381723, 0xff3b1925, 0xff3d1b27,
+					0xff401d29, 0xff421f2b, 0xff45212e, 0xff472330, 0xff4a2532, 0xff4c2734, 0xff4f2936, 0xff512b39,
+					0xff542e3b, 0xff56303d, 0xff59323f, 0xff5b3441, 0xff5e3644, 0xff603946, 0xff633b48, 0xff663d4b,
+					0xff683f4d, 0xff6b424f, 0xff6e4452, 0xff704654, 0xff734856, 0xff754b59, 0xff784d5b, 0xff7b4f5e,
+					0xff7d5260, 0xff805462, 0xff835765, 0xff865967, 0xff885b6a, 0xff8b5e6c, 0xff8e606f, 0xff916371,
+					0xff936574, 0xff966776, 0xff996a79, 0xff9c6c7b, 0xff9e6f7e, 0xffa17180, 0xffa47483, 0xffa77685,
+					0xffaa7988, 0xffac7b8a, 0xffaf7e8d, 0xffb28090, 0xffb58392, 0xffb88695, 0xffbb8897, 0xffbd8b9a,
+					0xffc08d9d, 0xffc3909f, 0xffc692a2, 0xffc995a5, 0xffcc98a7, 0xffcf9aaa, 0xffd29dad, 0xffd5a0af,
+					0xffd7a2b2, 0xffdaa5b5, 0xffdda8b7, 0xffe0aaba, 0xffe3adbd, 0xffe6b0c0, 0xffe9b2c2, 0xffecb5c5,
+					0xffefb8c8, 0xfff2bacb, 0xfff5bdcd, 0xfff8c0d0, 0xfffbc3d3, 0xfffec5d6, 0xffffc9d8, 0xffffcddb,
+					0xffffd1de, 0xffffd5e0, 0xffffd9e3, 0xffffdde5, 0xffffe1e8, 0xffffe4eb, 0xffffe8ed, 0xffffecf0,
+					0xfffff0f2, 0xfffff4f5, 0xfffff8f8, 0xfffffbff, 0xffffffff,
+				},
+			},
+			N1: ToneTable{
+				Hue:    298.98099721070395,
+				Chroma: 3.9880438645808614,
+				Tones: [101]int{
+					0xff000000, 0xff040305, 0xff08070a, 0xff0c0a0e, 0xff0f0e11, 0xff121014, 0xff141316, 0xff161518,
+					0xff18171a, 0xff1a191c, 0xff1c1b1e, 0xff1e1d20, 0xff201f22, 0xff232125, 0xff252327, 0xff272529,
+					0xff29272b, 0xff2b292d, 0xff2d2b2f, 0xff2f2d31, 0xff313033, 0xff343236, 0xff363438, 0xff38363a,
+					0xff3a383c, 0xff3d3b3e, 0xff3f3d41, 0xff413f43, 0xff434145, 0xff464448, 0xff48464a, 0xff4a484c,
+					0xff4d4a4e, 0xff4f4d51, 0xff524f53, 0xff545156, 0xff565458, 0xff59565a, 0xff5b595d, 0xff5e5b5f,
+					0xff605d62, 0xff636064, 0xff656266, 0xff676569, 0xff6a676b, 0xff6c696e, 0xff6f6c70, 0xff716e73,
+					0xff747175, 0xff777378, 0xff79767a, 0xff7c787d, 0xff7e7b7f, 0xff817d82, 0xff838084, 0xff868387,
+					0xff89858a, 0xff8b888c, 0xff8e8a8f, 0xff908d91, 0xff938f94, 0xff969297, 0xff989599, 0xff9b979c,
+					0xff9e9a9e, 0xffa09da1, 0xffa39fa4, 0xffa6a2a6, 0xffa9a4a9, 0xffaba7ac, 0xffaeaaae, 0xffb1adb1,
+					0xffb3afb4, 0xffb6b2b7, 0xffb9b5b9, 0xffbcb7bc, 0xffbebabf, 0xffc1bdc1, 0xffc4c0c4, 0xffc7c2c7,
+					0xffcac5ca, 0xffccc8cc, 0xffcfcbcf, 0xffd2cdd2, 0xffd5d0d5, 0xffd8d3d8, 0xffdbd6da, 0xffddd8dd,
+					0xffe0dbe0, 0xffe3dee3, 0xffe6e1e6, 0xffe9e4e9, 0xffece7eb, 0xffefe9ee, 0xfff2ecf1, 0xfff4eff4,
+					0xfff7f2f7, 0xfffaf5fa, 0xfffdf8fd, 0xfffffbff, 0xffffffff,
+				},
+			},
+			N2: ToneTable{
+				Hue:    298.98099721070395,
+				Chroma: 7.976087729161723,
+				Tones: [101]int{
+					0xff000000, 0xff040308, 0xff08060d, 0xff0c0a11, 0xff0f0d14, 0xff121017, 0xff14121a, 0xff17141c,
+					0xff19161e, 0xff1b1820, 0xff1d1a22, 0xff1f1c24, 0xff211e26, 0xff232028, 0xff25222b, 0xff27242d,
+					0xff29272f, 0xff2b2931, 0xff2e2b33, 0xff302d35, 0xff322f37, 0xff34313a, 0xff36333c, 0xff39363e,
+					0xff3b3840, 0xff3d3a43, 0xff3f3c45, 0xff423e47, 0xff44414a, 0xff46434c, 0xff49454e, 0xff4b4851,
+					0xff4d4a53, 0xff504c55, 0xff524e58, 0xff54515a, 0xff57535c, 0xff59565f, 0xff5c5861, 0xff5e5a64,
+					0xff615d66, 0xff635f69, 0xff65626b, 0xff68646e, 0xff6a6670, 0xff6d6973, 0xff6f6b75, 0xff726e78,
+					0xff75707a, 0xff77737d, 0xff7a757f, 0xff7c7882, 0xff7f7a84, 0xff817d87, 0xff847f89, 0xff87828c,
+					0xff89848f, 0xff8c8791, 0xff8e8a94, 0xff918c96, 0xff948f99, 0xff96919c, 0xff99949e, 0xff9c97a1,
+					0xff9e99a4, 0xffa19ca6, 0xffa49ea9, 0xffa6a1ac, 0xffa9a4ae, 0xffaca6b1, 0xffafa9b4, 0xffb1acb6,
+					0xffb4aeb9, 0xffb7b1bc, 0xffb9b4bf, 0xffbcb7c1, 0xffbfb9c4, 0xffc2bcc7, 0xffc5bfca, 0xffc7c1cc,
+					0xffcac4cf, 0xffcdc7d2, 0xffd0cad5, 0xffd3cdd8, 0xffd5cfda, 0xffd8d2dd, 0xffdbd5e0, 0xffded8e3,
+					0xffe1dae6, 0xffe4dde9, 0xffe7e0eb, 0xffe9e3ee, 0xffece6f1, 0xffefe9f4, 0xfff2ebf7, 0xfff5eefa,
+					0xfff8f1fd, 0xfffbf4ff, 0xfffdf7ff, 0xfffffbff, 0xffffffff,
+				},
+			},
+			Error: ToneTable{
+				Hue:    25.0,
+				Chroma: 84.0,
+				Tones: [101]int{
+					0xff000000, 0xff100000, 0xff1a0000, 0xff210001, 0xff280001, 0xff2d0001, 0xff310001, 0xff360001,
+					0xff390001, 0xff3d0002, 0xff410002, 0xff450002, 0xff490002, 0xff4d0002, 0xff500003, 0xff540003,
+					0xff580003, 0xff5c0004, 0xff600004, 0xff640004, 0xff690005, 0xff6d0005, 0xff710005, 0xff750006,
+					0xff790006, 0xff7e0007, 0xff820007, 0xff860008, 0xff8a0008, 0xff8f0009, 0xff93000a, 0xff98000a,
+					0xff9c000b, 0xffa0000c, 0xffa4020d, 0xffa80710, 0xffac0c12, 0xffaf1014, 0xffb31416, 0xffb61718,
+					0xffba1a1a, 0xffbd1e1d, 0xffc1211f, 0xffc52421, 0xffc82623, 0xffcc2925, 0xffcf2c27, 0xffd32f29,
+					0xffd7322c, 0xffda342e, 0xffde3730, 0xffe13a32, 0xffe53c34, 0xffe93f36, 0xffec4139, 0xfff0443b,
+					0xfff3473d, 0xfff7493f, 0xfffb4c42, 0xfffe4e44, 0xffff5449, 0xffff5b4f, 0xffff6154, 0xffff665a,
+					0xffff6c5f, 0xffff7164, 0xffff7669, 0xffff7b6e, 0xffff8073, 0xffff8578, 0xffff897d, 0xffff8e82,
+					0xffff9286, 0xffff978b, 0xffff9b90, 0xffff9f94, 0xffffa399, 0xffffa89d, 0xffffaca2, 0xffffb0a6,
+					0xffffb4ab, 0xffffb8af, 0xffffbcb3, 0xffffbfb8, 0xffffc3bc, 0xffffc7c0, 0xffffcbc5, 0xffffcfc9,
+					0xffffd3cd, 0xffffd6d1, 0xffffdad6, 0xffffdeda, 0xffffe2de, 0xffffe5e2, 0xffffe9e6, 0xffffedea,
+					0xfffff0ee, 0xfffff4f2, 0xfffff8f7, 0xfffffbff, 0xffffffff,
+				},
+			},
+		},
+		// Blue
+		0xff4285f4: {
+			A1: ToneTable{
+				Hue:    265.97939535792614,
+				Chroma: 62.26911127457101,
+				Tones: [101]int{
+					0xff000000, 0xff000310, 0xff00071a, 0xff000a22, 0xff000d28, 0xff00102d, 0xff001232, 0xff001436,
+					0xff00163a, 0xff00183d, 0xff001a41, 0xff001c45, 0xff001e49, 0xff00204d, 0xff002251, 0xff002455,
+					0xff002659, 0xff00285d, 0xff002a61, 0xff002c65, 0xff002e69, 0xff00306d, 0xff003271, 0xff003576,
+					0xff00377a, 0xff00397e, 0xff003b82, 0xff003d87, 0xff003f8b, 0xff00428f, 0xff004494, 0xff004698,
+					0xff00489d, 0xff004aa1, 0xff004da6, 0xff004faa, 0xff0051af, 0xff0054b3, 0xff0056b8, 0xff0058bd,
+					0xff005ac1, 0xff005dc6, 0xff005fcb, 0xff0162cf, 0xff0a64d2, 0xff1267d4, 0xff1869d7, 0xff1e6cda,
+					0xff226fdc, 0xff2771df, 0xff2b74e2, 0xff2f76e5, 0xff3279e7, 0xff367cea, 0xff397eed, 0xff3d81f0,
+					0xff4084f2, 0xff4386f5, 0xff4789f8, 0xff4a8cfb, 0xff4d8efe, 0xff5291ff, 0xff5894ff, 0xff5d97ff,
+					0xff629aff, 0xff689cff, 0xff6d9fff, 0xff72a2ff, 0xff77a5ff, 0xff7ba8ff, 0xff80aaff, 0xff85adff,
+					0xff89b0ff, 0xff8eb3ff, 0xff93b6ff, 0xff97b8ff, 0xff9cbbff, 0xffa0beff, 0xffa4c1ff, 0xffa9c4ff,
+					0xffadc6ff, 0xffb1c9ff, 0xffb6ccff, 0xffbacfff, 0xffbed2ff, 0xffc3d4ff, 0xffc7d7ff, 0xffcbdaff,
+					0xffcfddff, 0xffd4dfff, 0xffd8e2ff, 0xffdce5ff, 0xffe0e8ff, 0xffe4ebff, 0xffe9edff, 0xffedf0ff,
+					0xfff1f3ff, 0xfff5f6ff, 0xfff9f9ff, 0xfffefbff, 0xffffffff,
+				},
+			},
+			A2: ToneTable{
+				Hue:    265.97939535792614,
+				Chroma: 20.756370424857003,
+				Tones: [101]int{
+					0xff000000, 0xff000310, 0xff00071a, 0xff010a21, 0xff020e24, 0xff041027, 0xff071329, 0xff09152b,
+					0xff0b172e, 0xff0d1930, 0xff0f1b32, 0xff111d34, 0xff141f36, 0xff162138, 0xff18233a, 0xff1a263d,
+					0xff1c283f, 0xff1e2a41, 0xff202c43, 0xff232e46, 0xff253048, 0xff27324a, 0xff29354c, 0xff2b374f,
+					0xff2e3951, 0xff303b53, 0xff323e56, 0xff344058, 0xff37425b, 0xff39445d, 0xff3b475f, 0xff3e4962,
+					0xff404b64, 0xff424e67, 0xff455069, 0xff47526c, 0xff49556e, 0xff4c5771, 0xff4e5973, 0xff515c76,
+					0xff535e78, 0xff55617b, 0xff58637d, 0xff5a6680, 0xff5d6882, 0xff5f6a85, 0xff626d88, 0xff646f8a,
+					0xff67728d, 0xff69748f, 0xff6c7792, 0xff6e7995, 0xff717c97, 0xff737f9a, 0xff76819d, 0xff78849f,
+					0xff7b86a2, 0xff7d89a5, 0xff808ba7, 0xff838eaa, 0xff8591ad, 0xff8893af, 0xff8a96b2, 0xff8d98b5,
+					0xff909bb8, 0xff929eba, 0xff95a0bd, 0xff98a3c0, 0xff9aa6c3, 0xff9da8c5, 0xffa0abc8, 0xffa2aecb,
+					0xffa5b0ce, 0xffa8b3d0, 0xffaab6d3, 0xffadb9d6, 0xffb0bbd9, 0xffb3bedc, 0xffb5c1df, 0xffb8c4e1,
+					0xffbbc6e4, 0xffbec9e7, 0xffc0ccea, 0xffc3cfed, 0xffc6d1f0, 0xffc9d4f3, 0xffccd7f6, 0xffcedaf8,
+					0xffd1ddfb, 0xffd4dffe, 0xffd8e2ff, 0xffdce5ff, 0xffe0e8ff, 0xffe4ebff, 0xffe9edff, 0xffedf0ff,
+					0xfff1f3ff, 0xfff5f6ff, 0xfff9f9ff, 0xfffefbff, 0xffffffff,
+				},
+			},
+			A3: ToneTable{
+				Hue:    325.97939535792614,
+				Chroma: 31.134555637285505,
+				Tones: [101]int{
+					0xff000000, 0xff0b0010, 0xff14001a, 0xff1a0021, 0xff1e0125, 0xff210328, 0xff24052b, 0xff27072d,
+					0xff290a30, 0xff2b0c32, 0xff2d0e34, 0xff301036, 0xff321238, 0xff34153a, 0xff36173d, 0xff39193f,
+					0xff3b1b41, 0xff3d1d43, 0xff401f46, 0xff422248, 0xff44244a, 0xff47264d, 0xff49284f, 0xff4c2a51,
+					0xff4e2d54, 0xff512f56, 0xff533158, 0xff55335b, 0xff58365d, 0xff5a3860, 0xff5d3a62, 0xff5f3c64,
+					0xff623f67, 0xff644169, 0xff67436c, 0xff69466e, 0xff6c4871, 0xff6f4a73, 0xff714d76, 0xff744f78,
+					0xff76517b, 0xff79547e, 0xff7c5680, 0xff7e5983, 0xff815b85, 0xff835d88, 0xff86608a, 0xff89628d,
+					0xff8b6590, 0xff8e6792, 0xff916a95, 0xff936c98, 0xff966f9a, 0xff99719d, 0xff9c74a0, 0xff9e76a2,
+					0xffa179a5, 0xffa47ba8, 0xffa77eaa, 0xffa980ad, 0xffac83b0, 0xffaf86b3, 0xffb288b5, 0xffb48bb8,
+					0xffb78dbb, 0xffba90be, 0xffbd92c0, 0xffc095c3, 0xffc298c6, 0xffc59ac9, 0xffc89dcb, 0xffcba0ce,
+					0xffcea2d1, 0xffd1a5d4, 0xffd4a8d7, 0xffd6aada, 0xffd9addc, 0xffdcb0df, 0xffdfb2e2, 0xffe2b5e5,
+					0xffe5b8e8, 0xffe8bbeb, 0xffebbdee, 0xffeec0f0, 0xfff1c3f3, 0xfff4c6f6, 0xfff7c8f9, 0xfff9cbfc,
+					0xfffcceff, 0xfffdd2ff, 0xfffed6ff, 0xffffdaff, 0xffffdeff, 0xffffe2fe, 0xffffe7fd, 0xffffebfc,
+					0xffffeffc, 0xfffff3fb, 0xfffff7fa, 0xfffffbff, 0xffffffff,
+				},
+			},
+			N1: ToneTable{
+				Hue:    265.97939535792614,
+				Chroma: 4.0,
+				Tones: [101]int{
+					0xff000000, 0xff030405, 0xff06070a, 0xff0a0b0e, 0xff0d0e11, 0xff101114, 0xff121316, 0xff151518,
+					0xff17171b, 0xff19191d, 0xff1b1b1f, 0xff1d1d21, 0xff1f1f23, 0xff212125, 0xff232427, 0xff252629,
+					0xff27282b, 0xff292a2d, 0xff2b2c2f, 0xff2d2e31, 0xff303033, 0xff323236, 0xff343538, 0xff36373a,
+					0xff38393c, 0xff3b3b3f, 0xff3d3d41, 0xff3f4043, 0xff414245, 0xff444448, 0xff46464a, 0xff48494c,
+					0xff4b4b4f, 0xff4d4d51, 0xff4f5053, 0xff525256, 0xff545458, 0xff57575a, 0xff59595d, 0xff5b5c5f,
+					0xff5e5e62, 0xff606064, 0xff636367, 0xff656569, 0xff68686b, 0xff6a6a6e, 0xff6d6d70, 0xff6f6f73,
+					0xff727275, 0xff747478, 0xff77777a, 0xff79797d, 0xff7c7c7f, 0xff7e7e82, 0xff818185, 0xff848387,
+					0xff86868a, 0xff89888c, 0xff8b8b8f, 0xff8e8e91, 0xff919094, 0xff939397, 0xff969599, 0xff99989c,
+					0xff9b9b9f, 0xff9e9da1, 0xffa1a0a4, 0xffa3a3a7, 0xffa6a5a9, 0xffa9a8ac, 0xffababaf, 0xffaeadb1,
+					0xffb1b0b4, 0xffb4b3b7, 0xffb6b5b9, 0xffb9b8bc, 0xffbcbbbf, 0xffbfbec2, 0xffc1c0c4, 0xffc4c3c7,
+					0xffc7c6ca, 0xffcac9cd, 0xffcdcbcf, 0xffcfced2, 0xffd2d1d5, 0xffd5d4d8, 0xffd8d6db, 0xffdbd9dd,
+					0xffdedce0, 0xffe0dfe3, 0xffe3e2e6, 0xffe6e5e9, 0xffe9e7ec, 0xffeceaee, 0xffefedf1, 0xfff2f0f4,
+					0xfff5f3f7, 0xfff7f6fa, 0xfffaf9fd, 0xfffefbff, 0xffffffff,
+				},
+			},
+			N2: ToneTable{
+				Hue:    265.97939535792614,
+				Chroma: 8.0,
+				Tones: [101]int{
+					0xff000000, 0xff020408, 0xff05070d, 0xff080b11, 0xff0b0e15, 0xff0e1118, 0xff10131a, 0xff13161c,
+					0xff15181e, 0xff171a20, 0xff191b22, 0xff1b1d25, 0xff1d2027, 0xff1f2229, 0xff21242b, 0xff23262d,
+					0xff25282f, 0xff272a31, 0xff292c33, 0xff2b2e36, 0xff2e3038, 0xff30323a, 0xff32353c, 0xff34373e,
+					0xff363941, 0xff393b43, 0xff3b3d45, 0xff3d4048, 0xff3f424a, 0xff42444c, 0xff44474f, 0xff464951,
+					0xff494b53, 0xff4b4e56, 0xff4d5058, 0xff50525a, 0xff52555d, 0xff54575f, 0xff575962, 0xff595c64,
+					0xff5c5e66, 0xff5e6169, 0xff61636b, 0xff63656e, 0xff656870, 0xff686a73, 0xff6a6d75, 0xff6d6f78,
+					0xff6f727a, 0xff72747d, 0xff74777f, 0xff777982, 0xff7a7c85, 0xff7c7e87, 0xff7f818a, 0xff81838c,
+					0xff84868f, 0xff868992, 0xff898b94, 0xff8c8e97, 0xff8e9099, 0xff91939c, 0xff94969f, 0xff9698a1,
+					0xff999ba4, 0xff9c9da7, 0xff9ea0a9, 0xffa1a3ac, 0xffa4a5af, 0xffa6a8b1, 0xffa9abb4, 0xffacadb7,
+					0xffaeb0ba, 0xffb1b3bc, 0xffb4b6bf, 0xffb7b8c2, 0xffb9bbc5, 0xffbcbec7, 0xffbfc0ca, 0xffc2c3cd,
+					0xffc4c6d0, 0xffc7c9d2, 0xffcacbd5, 0xffcdced8, 0xffd0d1db, 0xffd2d4de, 0xffd5d7e0, 0xffd8d9e3,
+					0xffdbdce6, 0xffdedfe9, 0xffe1e2ec, 0xffe4e5ef, 0xffe6e8f2, 0xffe9eaf4, 0xffecedf7, 0xffeff0fa,
+					0xfff2f3fd, 0xfff5f6ff, 0xfff9f9ff, 0xfffefbff, 0xffffffff,
+				},
+			},
+			Error: ToneTable{
+				Hue:    25.0,
+				Chroma: 84.0,
+				Tones: [101]int{
+					0xff000000, 0xff100000, 0xff1a0000, 0xff210001, 0xff280001, 0xff2d0001, 0xff310001, 0xff360001,
+					0xff390001, 0xff3d0002, 0xff410002, 0xff450002, 0xff490002, 0xff4d0002, 0xff500003, 0xff540003,
+					0xff580003, 0xff5c0004, 0xff600004, 0xff640004, 0xff690005, 0xff6d0005, 0xff710005, 0xff750006,
+					0xff790006, 0xff7e0007, 0xff820007, 0xff860008, 0xff8a0008, 0xff8f0009, 0xff93000a, 0xff98000a,
+					0xff9c000b, 0xffa0000c, 0xffa4020d, 0xffa80710, 0xffac0c12, 0xffaf1014, 0xffb31416, 0xffb61718,
+					0xffba1a1a, 0xffbd1e1d, 0xffc1211f, 0xffc52421, 0xffc82623, 0xffcc2925, 0xffcf2c27, 0xffd32f29,
+					0xffd7322c, 0xffda342e, 0xffde3730, 0xffe13a32, 0xffe53c34, 0xffe93f36, 0xffec4139, 0xfff0443b,
+					0xfff3473d, 0xfff7493f, 0xfffb4c42, 0xfffe4e44, 0xffff5449, 0xffff5b4f, 0xffff6154, 0xffff665a,
+					0xffff6c5f, 0xffff7164, 0xffff7669, 0xffff7b6e, 0xffff8073, 0xffff8578, 0xffff897d, 0xffff8e82,
+					0xffff9286, 0xffff978b, 0xffff9b90, 0xffff9f94, 0xffffa399, 0xffffa89d, 0xffffaca2, 0xffffb0a6,
+					0xffffb4ab, 0xffffb8af, 0xffffbcb3, 0xffffbfb8, 0xffffc3bc, 0xffffc7c0, 0xffffcbc5, 0xffffcfc9,
+					0xffffd3cd, 0xffffd6d1, 0xffffdad6, 0xffffdeda, 0xffffe2de, 0xffffe5e2, 0xffffe9e6, 0xffffedea,
+					0xfffff0ee, 0xfffff4f2, 0xfffff8f7, 0xfffffbff, 0xffffffff,
+				},
+			},
+		},
+		// Red
+		0xffea4335: {
+			A1: ToneTable{
+				Hue:    26.28853590107876,
+				Chroma: 82.77797900786099,
+				Tones: [101]int{
+					0xff000000, 0xff100000, 0xff1a0000, 0xff220000, 0xff280000, 0xff2d0000, 0xff310000, 0xff360000,
+					0xff390001, 0xff3d0001, 0xff410001, 0xff450001, 0xff490001, 0xff4d0001, 0xff510001, 0xff540001,
+					0xff580001, 0xff5c0001, 0xff610002, 0xff650002, 0xff690002, 0xff6d0002, 0xff710002, 0xff750002,
+					0xff790003, 0xff7e0003, 0xff820003, 0xff860003, 0xff8b0004, 0xff8f0004, 0xff930004, 0xff980004,
+					0xff9c0005, 0xffa00407, 0xffa30809, 0xffa70d0b, 0xffaa110e, 0xffae1410, 0xffb21813, 0xffb51b15,
+					0xffb91e17, 0xffbc2119, 0xffc0241b, 0xffc3261e, 0xffc72920, 0xffcb2c22, 0xffce2f24, 0xffd23126,
+					0xffd53428, 0xffd9372b, 0xffdc392d, 0xffe03c2f, 0xffe43e31, 0xffe74133, 0xffeb4435, 0xffee4638,
+					0xfff2493a, 0xfff64b3c, 0xfff94e3e, 0xfffd5041, 0xffff5544, 0xffff5b4a, 0xffff6150, 0xffff6756,
+					0xffff6c5b, 0xffff7261, 0xffff7766, 0xffff7c6b, 0xffff8070, 0xffff8575, 0xffff8a7a, 0xffff8e7f,
+					0xffff9384, 0xffff9789, 0xffff9b8e, 0xffff9f92, 0xffffa497, 0xffffa89c, 0xffffaca0, 0xffffb0a5,
+					0xffffb4a9, 0xffffb8ae, 0xffffbcb2, 0xffffc0b7, 0xffffc3bb, 0xffffc7bf, 0xffffcbc4, 0xffffcfc8,
+					0xffffd3cc, 0xffffd6d1, 0xffffdad5, 0xffffded9, 0xffffe2dd, 0xffffe5e2, 0xffffe9e6, 0xffffedea,
+					0xfffff0ee, 0xfffff4f2, 0xfffff8f7, 0xfffffbff, 0xffffffff,
+				},
+			},
+			A2: ToneTable{
+				Hue:    26.28853590107876,
+				Chroma: 27.592659669287,
+				Tones: [101]int{
+					0xff000000, 0xff100000, 0xff1a0000, 0xff1f0201, 0xff230302, 0xff270503, 0xff2a0704, 0xff2d0906,
+					0xff300b07, 0xff330d09, 0xff350f0b, 0xff38110c, 0xff3a130e, 0xff3d1510, 0xff401712, 0xff421914,
+					0xff451b16, 0xff481d18, 0xff4a1f1a, 0xff4d211c, 0xff4f231e, 0xff522520, 0xff552722, 0xff572a24,
+					0xff5a2c26, 0xff5d2e28, 0xff60302a, 0xff62322c, 0xff65342e, 0xff683730, 0xff6a3932, 0xff6d3b34,
+					0xff703d37, 0xff734039, 0xff75423b, 0xff78443d, 0xff7b463f, 0xff7e4942, 0xff814b44, 0xff834d46,
+					0xff865048, 0xff89524b, 0xff8c544d, 0xff8f574f, 0xff915951, 0xff945c54, 0xff975e56, 0xff9a6058,
+					0xff9d635b, 0xffa0655d, 0xffa36860, 0xffa56a62, 0xffa86d64, 0xffab6f67, 0xffae7269, 0xffb1746c,
+					0xffb4776e, 0xffb77970, 0xffba7c73, 0xffbd7e75, 0xffc08178, 0xffc3837a, 0xffc6867d, 0xffc9887f,
+					0xffcb8b82, 0xffce8d84, 0xffd19087, 0xffd49389, 0xffd7958c, 0xffda988e, 0xffdd9a91, 0xffe09d94,
+					0xffe3a096, 0xffe6a299, 0xffe9a59b, 0xffeda89e, 0xfff0aaa1, 0xfff3ada3, 0xfff6b0a6, 0xfff9b2a8,
+					0xfffcb5ab, 0xffffb8ae, 0xffffbcb2, 0xffffc0b7, 0xffffc3bb, 0xffffc7bf, 0xffffcbc4, 0xffffcfc8,
+					0xffffd3cc, 0xffffd6d1, 0xffffdad5, 0xffffded9, 0xffffe2dd, 0xffffe5e2, 0xffffe9e6, 0xffffedea,
+					0xfffff0ee, 0xfffff4f2, 0xfffff8f7, 0xfffffbff, 0xffffffff,
+				},
+			},
+			A3: ToneTable{
+				Hue:    86.28853590107876,
+				Chroma: 41.388989503930496,
+				Tones: [101]int{
+					0xff000000, 0xff060300, 0xff0c0700, 0xff110a00, 0xff150d00, 0xff181000, 0xff1b1200, 0xff1e1400,
+					0xff211600, 0xff231800, 0xff251a00, 0xff281c00, 0xff2a1e00, 0xff2d1f00, 0xff302100, 0xff322300,
+					0xff352500, 0xff372700, 0xff3a2900, 0xff3d2c00, 0xff3f2e00, 0xff423000, 0xff453200, 0xff473400,
+					0xff4a3600, 0xff4d3800, 0xff503a00, 0xff533c00, 0xff553f00, 0xff584100, 0xff5b4300, 0xff5e4500,
+					0xff614700, 0xff644a00, 0xff674c00, 0xff6a4e00, 0xff6c5000, 0xff6f5300, 0xff725500, 0xff755700,
+					0xff785a00, 0xff7b5c00, 0xff7e5e00, 0xff816000, 0xff846300, 0xff886500, 0xff8a6802, 0xff8d6a06,
+					0xff906d0a, 0xff936f0e, 0xff967211, 0xff987414, 0xff9b7717, 0xff9e791a, 0xffa17c1d, 0xffa47e1f,
+					0xffa68122, 0xffa98325, 0xffac8627, 0xffaf882a, 0xffb28b2c, 0xffb58e2f, 0xffb89031, 0xffbb9334,
+					0xffbd9636, 0xffc09838, 0xffc39b3b, 0xffc69d3d, 0xffc9a040, 0xffcca342, 0xffcfa544, 0xffd2a847,
+					0xffd5ab49, 0xffd8ad4c, 0xffdbb04e, 0xffdeb350, 0xffe1b653, 0xffe4b855, 0xffe7bb58, 0xffeabe5a,
+					0xffedc15d, 0xfff0c35f, 0xfff3c661, 0xfff6c964, 0xfff9cc66, 0xfffcce69, 0xffffd16b, 0xffffd578,
+					0xffffd885, 0xffffdb91, 0xffffdf9d, 0xffffe2a9, 0xffffe5b4, 0xffffe8bf, 0xffffecc9, 0xffffefd4,
+					0xfffff2de, 0xfffff5e8, 0xfffff8f2, 0xfffffbff, 0xffffffff,
+				},
+			},
+			N1: ToneTable{
+				Hue:    26.28853590107876,
+				Chroma: 4.0,
+				Tones: [101]int{
+					0xff000000, 0xff060303, 0xff0b0606, 0xff0f0a09, 0xff120d0c, 0xff15100f, 0xff181211, 0xff1a1413,
+					0xff1c1615, 0xff1e1817, 0xff201a19, 0xff231c1b, 0xff251e1d, 0xff27201f, 0xff292221, 0xff2b2423,
+					0xff2d2625, 0xff2f2827, 0xff322a29, 0xff342d2c, 0xff362f2e, 0xff383130, 0xff3b3332, 0xff3d3534,
+					0xff3f3736, 0xff413a38, 0xff443c3b, 0xff463e3d, 0xff48403f, 0xff4b4341, 0xff4d4544, 0xff4f4746,
+					0xff524948, 0xff544c4b, 0xff574e4d, 0xff59504f, 0xff5c5352, 0xff5e5554, 0xff605856, 0xff635a59,
+					0xff655c5b, 0xff685f5d, 0xff6a6160, 0xff6d6462, 0xff6f6665, 0xff726867, 0xff746b69, 0xff776d6c,
+					0xff7a706e, 0xff7c7271, 0xff7f7573, 0xff817776, 0xff847a78, 0xff877c7b, 0xff897f7d, 0xff8c8180,
+					0xff8e8482, 0xff918785, 0xff948988, 0xff968c8a, 0xff998e8d, 0xff9c918f, 0xff9e9492, 0xffa19694,
+					0xffa49997, 0xffa69b9a, 0xffa99e9c, 0xffaca19f, 0xffafa3a2, 0xffb1a6a4, 0xffb4a9a7, 0xffb7abaa,
+					0xffbaaeac, 0xffbcb1af, 0xffbfb3b2, 0xffc2b6b4, 0xffc5b9b7, 0xffc8bcba, 0xffcabebc, 0xffcdc1bf,
+					0xffd0c4c2, 0xffd3c7c5, 0xffd6c9c7, 0xffd9ccca, 0xffdbcfcd, 0xffded2d0, 0xffe1d4d2, 0xffe4d7d5,
+					0xffe7dad8, 0xffeadddb, 0xffede0de, 0xfff0e2e0, 0xfff3e5e3, 0xfff5e8e6, 0xfff8ebe9, 0xfffbeeec,
+					0xfffef1ef, 0xfffff4f2, 0xfffff8f7, 0xfffffbff, 0xffffffff,
+				},
+			},
+			N2: ToneTable{
+				Hue:    26.28853590107876,
+				Chroma: 8.0,
+				Tones: [101]int{
+					0xff000000, 0xff080202, 0xff0e0504, 0xff130807, 0xff160b0a, 0xff190e0d, 0xff1c110f, 0xff1e1311,
+					0xff201513, 0xff231715, 0xff251917, 0xff271b19, 0xff291d1b, 0xff2b1f1d, 0xff2e211f, 0xff302321,
+					0xff322523, 0xff342725, 0xff362927, 0xff392b29, 0xff3b2d2b, 0xff3d2f2d, 0xff40312f, 0xff423431,
+					0xff443634, 0xff473836, 0xff493a38, 0xff4b3c3a, 0xff4e3f3c, 0xff50413f, 0xff534341, 0xff554543,
+					0xff584845, 0xff5a4a48, 0xff5c4c4a, 0xff5f4f4c, 0xff61514f, 0xff645351, 0xff665653, 0xff695856,
+					0xff6b5a58, 0xff6e5d5a, 0xff715f5d, 0xff73625f, 0xff766462, 0xff786764, 0xff7b6966, 0xff7d6b69,
+					0xff806e6b, 0xff83706e, 0xff857370, 0xff887573, 0xff8b7875, 0xff8d7a78, 0xff907d7a, 0xff937f7d,
+					0xff95827f, 0xff988582, 0xff9b8784, 0xff9d8a87, 0xffa08c89, 0xffa38f8c, 0xffa5918f, 0xffa89491,
+					0xffab9794, 0xffae9996, 0xffb09c99, 0xffb39f9c, 0xffb6a19e, 0xffb9a4a1, 0xffbba7a3, 0xffbea9a6,
+					0xffc1aca9, 0xffc4afab, 0xffc7b1ae, 0xffcab4b1, 0xffccb7b3, 0xffcfb9b6, 0xffd2bcb9, 0xffd5bfbc,
+					0xffd8c2be, 0xffdbc4c1, 0xffddc7c4, 0xffe0cac7, 0xffe3cdc9, 0xffe6cfcc, 0xffe9d2cf, 0xffecd5d2,
+					0xffefd8d4, 0xfff2dbd7, 0xfff5ddda, 0xfff8e0dd, 0xfffbe3df, 0xfffee6e2, 0xffffe9e6, 0xffffedea,
+					0xfffff0ee, 0xfffff4f2, 0xfffff8f7, 0xfffffbff, 0xffffffff,
+				},
+			},
+			Error: ToneTable{
+				Hue:    25.0,
+				Chroma: 84.0,
+				Tones: [101]int{
+					0xff000000, 0xff100000, 0xff1a0000, 0xff210001, 0xff280001, 0xff2d0001, 0xff310001, 0xff360001,
+					0xff390001, 0xff3d0002, 0xff410002, 0xff450002, 0xff490002, 0xff4d0002, 0xff500003, 0xff540003,
+					0xff580003, 0xff5c0004, 0xff600004, 0xff640004, 0xff690005, 0xff6d0005, 0xff710005, 0xff750006,
+					0xff790006, 0xff7e0007, 0xff820007, 0xff860008, 0xff8a0008, 0xff8f0009, 0xff93000a, 0xff98000a,
+					0xff9c000b, 0xffa0000c, 0xffa4020d, 0xffa80710, 0xffac0c12, 0xffaf1014, 0xffb31416, 0xffb61718,
+					0xffba1a1a, 0xffbd1e1d, 0xffc1211f, 0xffc52421, 0xffc82623, 0xffcc2925, 0xffcf2c27, 0xffd32f29,
+					0xffd7322c, 0xffda342e, 0xffde3730, 0xffe13a32, 0xffe53c34, 0xffe93f36, 0xffec4139, 0xfff0443b,
+					0xfff3473d, 0xfff7493f, 0xfffb4c42, 0xfffe4e44, 0xffff5449, 0xffff5b4f, 0xffff6154, 0xffff665a,
+					0xffff6c5f, 0xffff7164, 0xffff7669, 0xffff7b6e, 0xffff8073, 0xffff8578, 0xffff897d, 0xffff8e82,
+					0xffff9286, 0xffff978b, 0xffff9b90, 0xffff9f94, 0xffffa399, 0xffffa89d, 0xffffaca2, 0xffffb0a6,
+					0xffffb4ab, 0xffffb8af, 0xffffbcb3, 0xffffbfb8, 0xffffc3bc, 0xffffc7c0, 0xffffcbc5, 0xffffcfc9,
+					0xffffd3cd, 0xffffd6d1, 0xffffdad6, 0xffffdeda, 0xffffe2de, 0xffffe5e2, 0xffffe9e6, 0xffffedea,
+					0xfffff0ee, 0xfffff4f2, 0xfffff8f7, 0xfffffbff, 0xffffffff,
+				},
+			},
+		},
+		// Yellow
+		0xfffbbc05: {
+			A1: ToneTable{
+				Hue:    85.15821691557672,
+				Chroma: 59.78367336730782,
+				Tones: [101]int{
+					0xff000000, 0xff060300, 0xff0c0700, 0xff110a00, 0xff150d00, 0xff190f00, 0xff1c1200, 0xff1e1400,
+					0xff211600, 0xff231800, 0xff261a00, 0xff281b00, 0xff2b1d00, 0xff2d1f00, 0xff302100, 0xff332300,
+					0xff352500, 0xff382700, 0xff3a2900, 0xff3d2b00, 0xff402d00, 0xff432f00, 0xff453200, 0xff483400,
+					0xff4b3600, 0xff4e3800, 0xff503a00, 0xff533c00, 0xff563e00, 0xff594000, 0xff5c4300, 0xff5f4500,
+					0xff624700, 0xff644900, 0xff674c00, 0xff6a4e00, 0xff6d5000, 0xff705200, 0xff735500, 0xff765700,
+					0xff795900, 0xff7c5b00, 0xff7f5e00, 0xff826000, 0xff856200, 0xff896500, 0xff8c6700, 0xff8f6900,
+					0xff926c00, 0xff956e00, 0xff987100, 0xff9b7300, 0xff9e7500, 0xffa27800, 0xffa57a00, 0xffa87d00,
+					0xffab7f00, 0xffaf8200, 0xffb28400, 0xffb58700, 0xffb88900, 0xffbc8c00, 0xffbf8e00, 0xffc29100,
+					0xffc59300, 0xffc99600, 0xffcc9800, 0xffcf9b00, 0xffd39d00, 0xffd6a000, 0xffd9a200, 0xffdda500,
+					0xffe0a700, 0xffe4aa00, 0xffe7ad00, 0xffeaaf00, 0xffeeb200, 0xfff1b400, 0xfff5b700, 0xfff8ba00,
+					0xfffbbc06, 0xfffebf0d, 0xffffc32d, 0xffffc642, 0xffffca53, 0xffffcd62, 0xffffd170, 0xffffd47d,
+					0xffffd889, 0xffffdb95, 0xffffdea0, 0xffffe2ab, 0xffffe5b6, 0xffffe8c0, 0xffffeccb, 0xffffefd5,
+					0xfffff2df, 0xfffff5e8, 0xfffff8f2, 0xfffffbff, 0xffffffff,
+				},
+			},
+			A2: ToneTable{
+				Hue:    85.15821691557672,
+				Chroma: 19.92789112243594,
+				Tones: [101]int{
+					0xff000000, 0xff060300, 0xff0c0700, 0xff110a00, 0xff150d00, 0xff190f00, 0xff1c1200, 0xff1e1400,
+					0xff211600, 0xff231800, 0xff261a00, 0xff281b00, 0xff2a1e01, 0xff2d2002, 0xff2f2203, 0xff312404,
+					0xff342606, 0xff362808, 0xff382a09, 0xff3a2c0b, 0xff3d2e0d, 0xff3f300f, 0xff423311, 0xff443513,
+					0xff463715, 0xff493918, 0xff4b3b1a, 0xff4e3e1c, 0xff50401e, 0xff534220, 0xff554422, 0xff574724,
+					0xff5a4926, 0xff5c4b28, 0xff5f4e2a, 0xff61502c, 0xff64522e, 0xff675530, 0xff695733, 0xff6c5a35,
+					0xff6e5c37, 0xff715e39, 0xff73613b, 0xff76633e, 0xff796640, 0xff7b6842, 0xff7e6b44, 0xff806d47,
+					0xff837049, 0xff86724b, 0xff88754d, 0xff8b7750, 0xff8e7a52, 0xff907c54, 0xff937f57, 0xff968159,
+					0xff99845b, 0xff9b865e, 0xff9e8960, 0xffa18b62, 0xffa38e65, 0xffa69167, 0xffa9936a, 0xffac966c,
+					0xffae986e, 0xffb19b71, 0xffb49e73, 0xffb7a076, 0xffbaa378, 0xffbca67b, 0xffbfa87d, 0xffc2ab80,
+					0xffc5ae82, 0xffc8b085, 0xffcbb387, 0xffcdb68a, 0xffd0b98c, 0xffd3bb8f, 0xffd6be91, 0xffd9c194,
+					0xffdcc496, 0xffdfc699, 0xffe2c99c, 0xffe5cc9e, 0xffe7cfa1, 0xffead1a3, 0xffedd4a6, 0xfff0d7a9,
+					0xfff3daab, 0xfff6ddae, 0xfff9dfb1, 0xfffce2b3, 0xffffe5b6, 0xffffe8c0, 0xffffeccb, 0xffffefd5,
+					0xfffff2df, 0xfffff5e8, 0xfffff8f2, 0xfffffbff, 0xffffffff,
+				},
+			},
+			A3: ToneTable{
+				Hue:    145.1582169155767,
+				Chroma: 29.89183668365391,
+				Tones: [101]int{
+					0xff000000, 0xff000500, 0xff000a01, 0xff000f01, 0xff001201, 0xff001501, 0xff001802, 0xff001b02,
+					0xff001d02, 0xff001f03, 0xff012103, 0xff022405, 0xff042606, 0xff062808, 0xff082a0a, 0xff0a2c0b,
+					0xff0d2e0d, 0xff0f310f, 0xff113311, 0xff143513, 0xff163715, 0xff183a17, 0xff1b3c19, 0xff1d3e1b,
+					0xff1f401d, 0xff21431f, 0xff244522, 0xff264724, 0xff284a26, 0xff2a4c28, 0xff2d4e2a, 0xff2f512c,
+					0xff31532e, 0xff345630, 0xff365832, 0xff385b35, 0xff3a5d37, 0xff3d5f39, 0xff3f623b, 0xff42643d,
+					0xff446740, 0xff466942, 0xff496c44, 0xff4b6e46, 0xff4d7149, 0xff50734b, 0xff52764d, 0xff557850,
+					0xff577b52, 0xff5a7e54, 0xff5c8057, 0xff5e8359, 0xff61855b, 0xff63885e, 0xff668a60, 0xff688d62,
+					0xff6b9065, 0xff6d9267, 0xff70956a, 0xff72986c, 0xff759a6f, 0xff789d71, 0xff7aa073, 0xff7da276,
+					0xff7fa578, 0xff82a87b, 0xff84aa7d, 0xff87ad80, 0xff8ab082, 0xff8cb385, 0xff8fb587, 0xff91b88a,
+					0xff94bb8c, 0xff97be8f, 0xff99c092, 0xff9cc394, 0xff9fc697, 0xffa1c999, 0xffa4cb9c, 0xffa7ce9f,
+					0xffaad1a1, 0xffacd4a4, 0xffafd7a6, 0xffb2d9a9, 0xffb4dcac, 0xffb7dfae, 0xffbae2b1, 0xffbde5b4,
+					0xffbfe8b6, 0xffc2ebb9, 0xffc5edbc, 0xffc8f0be, 0xffcbf3c1, 0xffcdf6c4, 0xffd0f9c6, 0xffd3fcc9,
+					0xffd6ffcc, 0xffe1ffd8, 0xffecffe4, 0xfff6ffef, 0xffffffff,
+				},
+			},
+			N1: ToneTable{
+				Hue:    85.15821691557672,
+				Chroma: 4.0,
+				Tones: [101]int{
+					0xff000000, 0xff050402, 0xff090704, 0xff0d0b06, 0xff100e09, 0xff13110c, 0xff16130e, 0xff181510,
+					0xff1a1712, 0xff1c1914, 0xff1e1b16, 0xff201d18, 0xff221f1a, 0xff24211c, 0xff27231e, 0xff292520,
+					0xff2b2722, 0xff2d2924, 0xff2f2c26, 0xff312e28, 0xff34302a, 0xff36322c, 0xff38342e, 0xff3a3630,
+					0xff3d3933, 0xff3f3b35, 0xff413d37, 0xff433f39, 0xff46423b, 0xff48443e, 0xff4a4640, 0xff4d4842,
+					0xff4f4b44, 0xff524d47, 0xff544f49, 0xff56524b, 0xff59544e, 0xff5b5650, 0xff5e5952, 0xff605b55,
+					0xff635e57, 0xff656059, 0xff67625c, 0xff6a655e, 0xff6c6760, 0xff6f6a63, 0xff716c65, 0xff746f68,
+					0xff77716a, 0xff79746d, 0xff7c766f, 0xff7e7972, 0xff817b74, 0xff837e76, 0xff868079, 0xff89837b,
+					0xff8b857e, 0xff8e8881, 0xff918b83, 0xff938d86, 0xff969088, 0xff98928b, 0xff9b958d, 0xff9e9890,
+					0xffa19a92, 0xffa39d95, 0xffa6a098, 0xffa9a29a, 0xffaba59d, 0xffaea7a0, 0xffb1aaa2, 0xffb4ada5,
+					0xffb6b0a7, 0xffb9b2aa, 0xffbcb5ad, 0xffbfb8af, 0xffc1bab2, 0xffc4bdb5, 0xffc7c0b8, 0xffcac3ba,
+					0xffcdc5bd, 0xffcfc8c0, 0xffd2cbc2, 0xffd5cec5, 0xffd8d0c8, 0xffdbd3cb, 0xffded6cd, 0xffe0d9d0,
+					0xffe3dcd3, 0xffe6ded6, 0xffe9e1d8, 0xffece4db, 0xffefe7de, 0xfff2eae1, 0xfff5ede4, 0xfff8efe7,
+					0xfffaf2e9, 0xfffdf5ec, 0xfffff8f2, 0xfffffbff, 0xffffffff,
+				},
+			},
+			N2: ToneTable{
+				Hue:    85.15821691557672,
+				Chroma: 8.0,
+				Tones: [101]int{
+					0xff000000, 0xff060300, 0xff0b0701, 0xff0f0a03, 0xff120e05, 0xff151006, 0xff181308, 0xff1a150a,
+					0xff1c170c, 0xff1e190e, 0xff201b10, 0xff231d12, 0xff251f14, 0xff272116, 0xff292318, 0xff2b2519,
+					0xff2d271b, 0xff2f291d, 0xff322b1f, 0xff342d21, 0xff363024, 0xff383226, 0xff3b3428, 0xff3d362a,
+					0xff3f382c, 0xff423b2e, 0xff443d30, 0xff463f32, 0xff494134, 0xff4b4437, 0xff4d4639, 0xff50483b,
+					0xff524a3d, 0xff544d3f, 0xff574f42, 0xff595144, 0xff5c5446, 0xff5e5648, 0xff61594b, 0xff635b4d,
+					0xff665d4f, 0xff686052, 0xff6b6254, 0xff6d6556, 0xff706759, 0xff72695b, 0xff756c5d, 0xff776e60,
+					0xff7a7162, 0xff7c7365, 0xff7f7667, 0xff827869, 0xff847b6c, 0xff877d6e, 0xff898071, 0xff8c8373,
+					0xff8f8576, 0xff918878, 0xff948a7b, 0xff978d7d, 0xff998f80, 0xff9c9282, 0xff9f9585, 0xffa19787,
+					0xffa49a8a, 0xffa79d8c, 0xffaa9f8f, 0xffaca292, 0xffafa494, 0xffb2a797, 0xffb4aa99, 0xffb7ac9c,
+					0xffbaaf9f, 0xffbdb2a1, 0xffc0b5a4, 0xffc2b7a6, 0xffc5baa9, 0xffc8bdac, 0xffcbbfae, 0xffcec2b1,
+					0xffd0c5b4, 0xffd3c8b6, 0xffd6cbb9, 0xffd9cdbc, 0xffdcd0bf, 0xffdfd3c1, 0xffe2d6c4, 0xffe4d8c7,
+					0xffe7dbc9, 0xffeadecc, 0xffede1cf, 0xfff0e4d2, 0xfff3e7d4, 0xfff6e9d7, 0xfff9ecda, 0xfffcefdd,
+					0xfffff2e0, 0xfffff5e8, 0xfffff8f2, 0xfffffbff, 0xffffffff,
+				},
+			},
+			Error: ToneTable{
+				Hue:    25.0,
+				Chroma: 84.0,
+				Tones: [101]int{
+					0xff000000, 0xff100000, 0xff1a0000, 0xff210001, 0xff280001, 0xff2d0001, 0xff310001, 0xff360001,
+					0xff390001, 0xff3d0002, 0xff410002, 0xff450002, 0xff490002, 0xff4d0002, 0xff500003, 0xff540003,
+					0xff580003, 0xff5c0004, 0xff600004, 0xff640004, 0xff690005, 0xff6d0005, 0xff710005, 0xff750006,
+					0xff790006, 0xff7e0007, 0xff820007, 0xff860008, 0xff8a0008, 0xff8f0009, 0xff93000a, 0xff98000a,
+					0xff9c000b, 0xffa0000c, 0xffa4020d, 0xffa80710, 0xffac0c12, 0xffaf1014, 0xffb31416, 0xffb61718,
+					0xffba1a1a, 0xffbd1e1d, 0xffc1211f, 0xffc52421, 0xffc82623, 0xffcc2925, 0xffcf2c27, 0xffd32f29,
+					0xffd7322c, 0xffda342e, 0xffde3730, 0xffe13a32, 0xffe53c34, 0xffe93f36, 0xffec4139, 0xfff0443b,
+					0xfff3473d, 0xfff7493f, 0xfffb4c42, 0xfffe4e44, 0xffff5449, 0xffff5b4f, 0xffff6154, 0xffff665a,
+					0xffff6c5f, 0xffff7164, 0xffff7669, 0xffff7b6e, 0xffff8073, 0xffff8578, 0xffff897d, 0xffff8e82,
+					0xffff9286, 0xffff978b, 0xffff9b90, 0xffff9f94, 0xffffa399, 0xffffa89d, 0xffffaca2, 0xffffb0a6,
+					0xffffb4ab, 0xffffb8af, 0xffffbcb3, 0xffffbfb8, 0xffffc3bc, 0xffffc7c0, 0xffffcbc5, 0xffffcfc9,
+					0xffffd3cd, 0xffffd6d1, 0xffffdad6, 0xffffdeda, 0xffffe2de, 0xffffe5e2, 0xffffe9e6, 0xffffedea,
+					0xfffff0ee, 0xfffff4f2, 0xfffff8f7, 0xfffffbff, 0xffffffff,
+				},
+			},
+		},
+		// Green
+		0xff34a853: {
+			A1: ToneTable{
+				Hue:    150.24025427898678,
+				Chroma: 60.662876464401435,
+				Tones: [101]int{
+					0xff000000, 0xff000501, 0xff000a02, 0xff000e02, 0xff001203, 0xff001504, 0xff001805, 0xff001b06,
+					0xff001d07, 0xff001f07, 0xff002108, 0xff002409, 0xff00260a, 0xff00280c, 0xff002b0d, 0xff002d0e,
+					0xff002f0f, 0xff003210, 0xff003411, 0xff003712, 0xff003914, 0xff003c15, 0xff003e16, 0xff004117,
+					0xff004318, 0xff004619, 0xff00481b, 0xff004b1c, 0xff004d1d, 0xff00501e, 0xff005320, 0xff005521,
+					0xff005822, 0xff005b23, 0xff005d25, 0xff006026, 0xff006327, 0xff006528, 0xff00682a, 0xff006b2b,
+					0xff006e2c, 0xff00702e, 0xff00732f, 0xff007630, 0xff007932, 0xff007c33, 0xff007e34, 0xff008136,
+					0xff008437, 0xff008738, 0xff008a3a, 0xff048d3b, 0xff0c8f3e, 0xff139240, 0xff189542, 0xff1d9745,
+					0xff219a47, 0xff259d49, 0xff29a04c, 0xff2da24e, 0xff30a550, 0xff34a853, 0xff37aa55, 0xff3aad58,
+					0xff3db05a, 0xff41b35c, 0xff44b65f, 0xff47b861, 0xff4abb64, 0xff4dbe66, 0xff50c168, 0xff53c46b,
+					0xff56c66d, 0xff58c970, 0xff5bcc72, 0xff5ecf75, 0xff61d277, 0xff64d57a, 0xff67d87c, 0xff6ada7f,
+					0xff6ddd81, 0xff6fe084, 0xff72e386, 0xff75e689, 0xff78e98b, 0xff7bec8e, 0xff7eef90, 0xff80f293,
+					0xff83f595, 0xff86f898, 0xff89fa9b, 0xff8cfd9d, 0xff96ffa4, 0xffa8ffb0, 0xffb7ffbc, 0xffc6ffc7,
+					0xffd3ffd2, 0xffdfffdd, 0xffebffe7, 0xfff6fff1, 0xffffffff,
+				},
+			},
+			A2: ToneTable{
+				Hue:    150.24025427898678,
+				Chroma: 20.220958821467146,
+				Tones: [101]int{
+					0xff000000, 0xff000501, 0xff000a02, 0xff000e02, 0xff001203, 0xff011505, 0xff031806, 0xff041a08,
+					0xff061c0a, 0xff081e0c, 0xff0a200e, 0xff0c2210, 0xff0e2411, 0xff102613, 0xff122815, 0xff142b17,
+					0xff162d19, 0xff192f1b, 0xff1b311d, 0xff1d331f, 0xff1f3521, 0xff213823, 0xff233a25, 0xff253c27,
+					0xff283e2a, 0xff2a412c, 0xff2c432e, 0xff2e4530, 0xff314832, 0xff334a34, 0xff354c36, 0xff374f39,
+					0xff3a513b, 0xff3c533d, 0xff3e563f, 0xff405841, 0xff435b44, 0xff455d46, 0xff475f48, 0xff4a624b,
+					0xff4c644d, 0xff4f674f, 0xff516951, 0xff536c54, 0xff566e56, 0xff587158, 0xff5b735b, 0xff5d765d,
+					0xff5f7860, 0xff627b62, 0xff647d64, 0xff678067, 0xff698269, 0xff6c856c, 0xff6e886e, 0xff718a71,
+					0xff738d73, 0xff768f76, 0xff799278, 0xff7b957b, 0xff7e977d, 0xff809a80, 0xff839d82, 0xff859f85,
+					0xff88a287, 0xff8ba58a, 0xff8da78c, 0xff90aa8f, 0xff92ad91, 0xff95af94, 0xff98b297, 0xff9ab599,
+					0xff9db89c, 0xffa0ba9e, 0xffa2bda1, 0xffa5c0a4, 0xffa8c3a6, 0xffaac5a9, 0xffadc8ac, 0xffb0cbae,
+					0xffb3ceb1, 0xffb5d0b4, 0xffb8d3b6, 0xffbbd6b9, 0xffbed9bc, 0xffc0dcbe, 0xffc3dfc1, 0xffc6e1c4,
+					0xffc9e4c7, 0xffcce7c9, 0xffceeacc, 0xffd1edcf, 0xffd4f0d2, 0xffd7f2d4, 0xffdaf5d7, 0xffdcf8da,
+					0xffdffbdd, 0xffe2fedf, 0xffebffe7, 0xfff6fff1, 0xffffffff,
+				},
+			},
+			A3: ToneTable{
+				Hue:    210.24025427898678,
+				Chroma: 30.331438232200718,
+				Tones: [101]int{
+					0xff000000, 0xff000506, 0xff00090b, 0xff000d10, 0xff001114, 0xff001417, 0xff00161a, 0xff00191d,
+					0xff001b1f, 0xff001d22, 0xff001f24, 0xff002226, 0xff002429, 0xff00262b, 0xff00282e, 0xff002b30,
+					0xff002d33, 0xff002f35, 0xff003138, 0xff00343a, 0xff00363d, 0xff003940, 0xff003b42, 0xff003d45,
+					0xff004048, 0xff00424a, 0xff00454d, 0xff004750, 0xff004a52, 0xff004c55, 0xff004f58, 0xff00515b,
+					0xff03545d, 0xff085660, 0xff0d5862, 0xff125b65, 0xff165d67, 0xff1a606a, 0xff1d626c, 0xff21646e,
+					0xff246771, 0xff276973, 0xff2a6c76, 0xff2d6e79, 0xff30717b, 0xff33737e, 0xff367680, 0xff387883,
+					0xff3b7b85, 0xff3e7e88, 0xff41808a, 0xff43838d, 0xff468590, 0xff498892, 0xff4c8a95, 0xff4e8d98,
+					0xff51909a, 0xff54929d, 0xff56959f, 0xff5997a2, 0xff5c9aa5, 0xff5e9da7, 0xff619faa, 0xff64a2ad,
+					0xff66a5b0, 0xff69a7b2, 0xff6caab5, 0xff6fadb8, 0xff71b0ba, 0xff74b2bd, 0xff77b5c0, 0xff79b8c3,
+					0xff7cbac6, 0xff7fbdc8, 0xff82c0cb, 0xff84c3ce, 0xff87c5d1, 0xff8ac8d3, 0xff8ccbd6, 0xff8fced9,
+					0xff92d1dc, 0xff95d3df, 0xff97d6e2, 0xff9ad9e4, 0xff9ddce7, 0xffa0dfea, 0xffa2e2ed, 0xffa5e4f0,
+					0xffa8e7f3, 0xffabeaf6, 0xffaeedf8, 0xffb0f0fb, 0xffb3f3fe, 0xffbcf5ff, 0xffc7f6ff, 0xffd1f8ff,
+					0xffdaf9ff, 0xffe4fbff, 0xffedfcff, 0xfff6feff, 0xffffffff,
+				},
+			},
+			N1: ToneTable{
+				Hue:    150.24025427898678,
+				Chroma: 4.0,
+				Tones: [101]int{
+					0xff000000, 0xff030403, 0xff060806, 0xff090c09, 0xff0c0f0c, 0xff0f120f, 0xff111411, 0xff141613,
+					0xff161815, 0xff181a17, 0xff1a1c19, 0xff1c1e1b, 0xff1e201d, 0xff20221f, 0xff222421, 0xff242623,
+					0xff262925, 0xff282b27, 0xff2a2d29, 0xff2c2f2b, 0xff2e312d, 0xff313330, 0xff333532, 0xff353834,
+					0xff373a36, 0xff3a3c38, 0xff3c3e3b, 0xff3e413d, 0xff40433f, 0xff434541, 0xff454743, 0xff474a46,
+					0xff4a4c48, 0xff4c4e4a, 0xff4e514d, 0xff51534f, 0xff535551, 0xff555854, 0xff585a56, 0xff5a5d58,
+					0xff5d5f5b, 0xff5f615d, 0xff626460, 0xff646662, 0xff666964, 0xff696b67, 0xff6b6e69, 0xff6e706c,
+					0xff70736e, 0xff737571, 0xff767873, 0xff787a76, 0xff7b7d78, 0xff7d7f7b, 0xff80827d, 0xff828480,
+					0xff858782, 0xff878a85, 0xff8a8c87, 0xff8d8f8a, 0xff8f918c, 0xff92948f, 0xff959792, 0xff979994,
+					0xff9a9c97, 0xff9d9e99, 0xff9fa19c, 0xffa2a49f, 0xffa5a6a1, 0xffa7a9a4, 0xffaaaca7, 0xffadaea9,
+					0xffafb1ac, 0xffb2b4af, 0xffb5b7b1, 0xffb8b9b4, 0xffbabcb7, 0xffbdbfb9, 0xffc0c2bc, 0xffc3c4bf,
+					0xffc6c7c2, 0xffc8cac4, 0xffcbcdc7, 0xffcecfca, 0xffd1d2cd, 0xffd4d5cf, 0xffd6d8d2, 0xffd9dbd5,
+					0xffdcddd8, 0xffdfe0db, 0xffe2e3dd, 0xffe5e6e0, 0xffe8e9e3, 0xffeaece6, 0xffedeee9, 0xfff0f1eb,
+					0xfff3f4ee, 0xfff6f7f1, 0xfff9faf4, 0xfffcfdf7, 0xffffffff,
+				},
+			},
+			N2: ToneTable{
+				Hue:    150.24025427898678,
+				Chroma: 8.0,
+				Tones: [101]int{
+					0xff000000, 0xff010502, 0xff040904, 0xff070d07, 0xff09100a, 0xff0c130c, 0xff0e150f, 0xff111711,
+					0xff131913, 0xff151b15, 0xff171d16, 0xff191f18, 0xff1b211a, 0xff1d231c, 0xff1f251e, 0xff212720,
+					0xff232a22, 0xff252c24, 0xff272e26, 0xff293029, 0xff2b322b, 0xff2d342d, 0xff30372f, 0xff323931,
+					0xff343b33, 0xff363d35, 0xff384038, 0xff3b423a, 0xff3d443c, 0xff3f463e, 0xff424940, 0xff444b43,
+					0xff464d45, 0xff485047, 0xff4b524a, 0xff4d544c, 0xff50574e, 0xff525951, 0xff545c53, 0xff575e55,
+					0xff596058, 0xff5c635a, 0xff5e655c, 0xff60685f, 0xff636a61, 0xff656d64, 0xff686f66, 0xff6a7268,
+					0xff6d746b, 0xff6f776d, 0xff727970, 0xff747c72, 0xff777e75, 0xff798177, 0xff7c837a, 0xff7e867c,
+					0xff81887f, 0xff848b81, 0xff868e84, 0xff899086, 0xff8b9389, 0xff8e958b, 0xff91988e, 0xff939b91,
+					0xff969d93, 0xff99a096, 0xff9ba398, 0xff9ea59b, 0xffa1a89e, 0xffa3aba0, 0xffa6ada3, 0xffa9b0a6,
+					0xffabb3a8, 0xffaeb5ab, 0xffb1b8ae, 0xffb4bbb0, 0xffb6beb3, 0xffb9c0b6, 0xffbcc3b8, 0xffbfc6bb,
+					0xffc1c9be, 0xffc4cbc1, 0xffc7cec3, 0xffcad1c6, 0xffccd4c9, 0xffcfd7cb, 0xffd2d9ce, 0xffd5dcd1,
+					0xffd8dfd4, 0xffdbe2d7, 0xffdde5d9, 0xffe0e7dc, 0xffe3eadf, 0xffe6ede2, 0xffe9f0e5, 0xffecf3e7,
+					0xffeff6ea, 0xfff2f9ed, 0xfff4fcf0, 0xfff7fef3, 0xffffffff,
+				},
+			},
+			Error: ToneTable{
+				Hue:    25.0,
+				Chroma: 84.0,
+				Tones: [101]int{
+					0xff000000, 0xff100000, 0xff1a0000, 0xff210001, 0xff280001, 0xff2d0001, 0xff310001, 0xff360001,
+					0xff390001, 0xff3d0002, 0xff410002, 0xff450002, 0xff490002, 0xff4d0002, 0xff500003, 0xff540003,
+					0xff580003, 0xff5c0004, 0xff600004, 0xff640004, 0xff690005, 0xff6d0005, 0xff710005, 0xff750006,
+					0xff790006, 0xff7e0007, 0xff820007, 0xff860008, 0xff8a0008, 0xff8f0009, 0xff93000a, 0xff98000a,
+					0xff9c000b, 0xffa0000c, 0xffa4020d, 0xffa80710, 0xffac0c12, 0xffaf1014, 0xffb31416, 0xffb61718,
+					0xffba1a1a, 0xffbd1e1d, 0xffc1211f, 0xffc52421, 0xffc82623, 0xffcc2925, 0xffcf2c27, 0xffd32f29,
+					0xffd7322c, 0xffda342e, 0xffde3730, 0xffe13a32, 0xffe53c34, 0xffe93f36, 0xffec4139, 0xfff0443b,
+					0xfff3473d, 0xfff7493f, 0xfffb4c42, 0xfffe4e44, 0xffff5449, 0xffff5b4f, 0xffff6154, 0xffff665a,
+					0xffff6c5f, 0xffff7164, 0xffff7669, 0xffff7b6e, 0xffff8073, 0xffff8578, 0xffff897d, 0xffff8e82,
+					0xffff9286, 0xffff978b, 0xffff9b90, 0xffff9f94, 0xffffa399, 0xffffa89d, 0xffffaca2, 0xffffb0a6,
+					0xffffb4ab, 0xffffb8af, 0xffffbcb3, 0xffffbfb8, 0xffffc3bc, 0xffffc7c0, 0xffffcbc5, 0xffffcfc9,
+					0xffffd3cd, 0xffffd6d1, 0xffffdad6, 0xffffdeda, 0xffffe2de, 0xffffe5e2, 0xffffe9e6, 0xffffedea,
+					0xfffff0ee, 0xfffff4f2, 0xfffff8f7, 0xfffffbff, 0xffffffff,
+				},
+			},
+		},
+	}
+}
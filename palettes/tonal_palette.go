@@ -16,14 +16,32 @@ package palettes
 
 import (
 	"github.com/gio-eui/md3-colors/hct"
+	colorUtils "github.com/gio-eui/md3-colors/utils/color"
+	mathUtils "github.com/gio-eui/md3-colors/utils/math"
 	"math"
+	"sync"
 )
 
+// Tones are cached in hundredths of a tone; tones closer together than that share a color.
+const toneCacheScale = 100.0
+
+// toneCacheLimit bounds the number of cached tones of a TonalPalette. The cache is cleared
+// when it is full.
+const toneCacheLimit = 256
+
+// TonalPalette holds the colors of a hue and chroma at every tone. It is safe for concurrent
+// use, so palettes can be shared, as the palettes of a theme and the generated tables are.
 type TonalPalette struct {
-	cache    map[int]int
-	keyColor *hct.Hct
-	hue      float64
-	chroma   float64
+	// mu guards cache and gamutCache.
+	mu         sync.Mutex
+	cache      map[int]hct.Hct
+	gamutCache map[int][]float64
+	keyColor   *hct.Hct
+	keyOnce    sync.Once
+	tones      *[101]int
+	hue        float64
+	chroma     float64
+	gamut      colorUtils.Gamut
 }
 
 // NewTonalPaletteFromInt creates a TonalPalette from an ARGB color.
@@ -40,56 +58,191 @@ func NewTonalPaletteFromHct(hct *hct.Hct) *TonalPalette {
 
 // NewTonalPaletteFromHueChroma creates a TonalPalette from a hue and chroma.
 func NewTonalPaletteFromHueChroma(hue, chroma float64) *TonalPalette {
+	return NewTonalPaletteFromHueChromaInGamut(hue, chroma, colorUtils.GamutSrgb)
+}
+
+// NewTonalPaletteFromHueChromaInGamut creates a TonalPalette from a hue and chroma whose tones
+// are also available in a wide [gamut], see ToneInGamut.
+//
+// Tone keeps returning sRGB colors, which serve as fallbacks for displays limited to sRGB.
+func NewTonalPaletteFromHueChromaInGamut(hue, chroma float64, gamut colorUtils.Gamut) *TonalPalette {
 	return &TonalPalette{
-		cache:    make(map[int]int),
-		keyColor: createKeyColor(hue, chroma),
-		hue:      hue,
-		chroma:   chroma,
+		cache:      make(map[int]hct.Hct),
+		gamutCache: make(map[int][]float64),
+		hue:        hue,
+		chroma:     chroma,
+		gamut:      gamut,
 	}
 }
 
-// createKeyColor creates the key color of the TonalPalette.
+// keyColorMaxChroma is requested from the HCT solver to find the highest chroma of a tone.
+const keyColorMaxChroma = 200.0
+
+// createKeyColor creates the key color of the TonalPalette: the color with [hue] whose chroma
+// is closest to [chroma], preferring tones closer to T50.
+//
+// Because T50 has the most chroma available on average, the search pivots around it, and
+// binary searches the tones for one that can provide the requested chroma.
 func createKeyColor(hue, chroma float64) *hct.Hct {
-	startTone := 50.0
-	smallestDeltaHct := hct.NewHct(hue, chroma, startTone)
-	smallestDelta := math.Abs(smallestDeltaHct.GetChroma() - chroma)
+	pivotTone := 50
+	toneStepSize := 1
+	// Epsilon to accept values slightly higher than the requested chroma.
+	epsilon := 0.01
 
-	for delta := 1.0; delta < 50.0; delta += 1.0 {
-		if math.Round(chroma) == math.Round(smallestDeltaHct.GetChroma()) {
-			return smallestDeltaHct
+	chromaCache := make(map[int]float64)
+	maxChroma := func(tone int) float64 {
+		maxChroma, ok := chromaCache[tone]
+		if !ok {
+			maxChroma = hct.NewHctResolved(hue, keyColorMaxChroma, float64(tone)).GetChroma()
+			chromaCache[tone] = maxChroma
 		}
+		return maxChroma
+	}
 
-		hctAdd := hct.NewHct(hue, chroma, startTone+delta)
-		hctAddDelta := math.Abs(hctAdd.GetChroma() - chroma)
-		if hctAddDelta < smallestDelta {
-			smallestDelta = hctAddDelta
-			smallestDeltaHct = hctAdd
-		}
+	lowerTone := 0
+	upperTone := 100
+	for lowerTone < upperTone {
+		midTone := (lowerTone + upperTone) / 2
+		isAscending := maxChroma(midTone) < maxChroma(midTone+toneStepSize)
+		sufficientChroma := maxChroma(midTone) >= chroma-epsilon
 
-		hctSubtract := hct.NewHct(hue, chroma, startTone-delta)
-		hctSubtractDelta := math.Abs(hctSubtract.GetChroma() - chroma)
-		if hctSubtractDelta < smallestDelta {
-			smallestDelta = hctSubtractDelta
-			smallestDeltaHct = hctSubtract
+		if sufficientChroma {
+			// Either range [lowerTone, midTone] or [midTone, upperTone] has the answer, so
+			// search in the range that is closer to the pivot tone.
+			if math.Abs(float64(lowerTone-pivotTone)) < math.Abs(float64(upperTone-pivotTone)) {
+				upperTone = midTone
+			} else {
+				if lowerTone == midTone {
+					return hct.NewHctResolved(hue, chroma, float64(lowerTone))
+				}
+				lowerTone = midTone
+			}
+		} else {
+			// As there is no sufficient chroma in the midTone, follow the direction to the
+			// chroma peak.
+			if isAscending {
+				lowerTone = midTone + toneStepSize
+			} else {
+				// Keep midTone for potential chroma peak.
+				upperTone = midTone
+			}
 		}
 	}
 
-	return smallestDeltaHct
+	return hct.NewHctResolved(hue, chroma, float64(lowerTone))
 }
 
 // Tone returns an ARGB color with the HCT hue and chroma of the TonalPalette and the provided tone.
-func (tp *TonalPalette) Tone(tone int) int {
-	color, ok := tp.cache[tone]
-	if !ok {
-		color = hct.NewHct(tp.hue, tp.chroma, float64(tone)).ToInt()
-		tp.cache[tone] = color
+//
+// Fractional tones are supported; tones are rounded to the nearest hundredth.
+func (tp *TonalPalette) Tone(tone float64) int {
+	if tp.tones != nil {
+		if key := toneKey(tone); key%toneCacheScale == 0 {
+			return tp.tones[key/toneCacheScale]
+		}
+	}
+	color := tp.cachedHct(tone)
+	return color.ToInt()
+}
+
+// Tones returns the ARGB colors of the TonalPalette for each of the provided tones, in order.
+func (tp *TonalPalette) Tones(tones ...float64) []int {
+	colors := make([]int, len(tones))
+	for i, tone := range tones {
+		colors[i] = tp.Tone(tone)
+	}
+	return colors
+}
+
+// ToneRamp returns the ARGB colors of the TonalPalette from tone 0 to tone 100, every [step]
+// tones. Tone 100 is always included as the last color.
+//
+// Steps finer than the 0.01 tone resolution of the TonalPalette are raised to it, as closer
+// tones share a color, and steps above 100 are lowered to 100. ToneRamp returns nil if [step]
+// is not positive.
+func (tp *TonalPalette) ToneRamp(step float64) []int {
+	if !(step > 0) {
+		return nil
+	}
+	step = mathUtils.ClampDouble(1.0/toneCacheScale, 100.0, step)
+	tones := make([]float64, 0, int(math.Ceil(100.0/step))+1)
+	for i := 0; float64(i)*step < 100.0; i++ {
+		tones = append(tones, float64(i)*step)
 	}
+	return tp.Tones(append(tones, 100.0)...)
+}
+
+// cachedHct returns the HCT color with the provided tone, rounded to the cache resolution.
+func (tp *TonalPalette) cachedHct(tone float64) hct.Hct {
+	key := toneKey(tone)
+	tp.mu.Lock()
+	color, ok := tp.cache[key]
+	tp.mu.Unlock()
+	if ok {
+		return color
+	}
+	// Solve without holding the lock; goroutines racing for the same tone store equal colors.
+	color = *hct.NewHct(tp.hue, tp.chroma, float64(key)/toneCacheScale)
+	tp.mu.Lock()
+	if len(tp.cache) >= toneCacheLimit {
+		tp.cache = make(map[int]hct.Hct)
+	}
+	tp.cache[key] = color
+	tp.mu.Unlock()
 	return color
 }
 
-// GetHct returns the HCT color with the specified tone.
+// toneKey quantises [tone] to a key of the tone cache.
+func toneKey(tone float64) int {
+	return int(math.Round(mathUtils.ClampDouble(0.0, 100.0, tone) * toneCacheScale))
+}
+
+// ToneInGamut returns the color with the HCT hue and chroma of the TonalPalette and the provided
+// tone in the gamut of the TonalPalette, as gamma-encoded R, G, and B components between 0.0
+// and 1.0, along with its sRGB fallback in ARGB format.
+//
+// High-chroma tones that sRGB has to clip keep more of their chroma in a wide gamut.
+func (tp *TonalPalette) ToneInGamut(tone float64) ([]float64, int) {
+	key := toneKey(tone)
+	tp.mu.Lock()
+	rgb, ok := tp.gamutCache[key]
+	tp.mu.Unlock()
+	if !ok {
+		rgb = hct.SolveInGamut(tp.hue, tp.chroma, float64(key)/toneCacheScale, tp.gamut)
+		tp.mu.Lock()
+		if len(tp.gamutCache) >= toneCacheLimit {
+			tp.gamutCache = make(map[int][]float64)
+		}
+		tp.gamutCache[key] = rgb
+		tp.mu.Unlock()
+	}
+	return []float64{rgb[0], rgb[1], rgb[2]}, tp.Tone(tone)
+}
+
+// GetGamut returns the gamut of the colors returned by ToneInGamut.
+func (tp *TonalPalette) GetGamut() colorUtils.Gamut {
+	return tp.gamut
+}
+
+// Oklch returns the OKLCH coordinates of the color with the provided tone.
+func (tp *TonalPalette) Oklch(tone float64) []float64 {
+	return colorUtils.OklchFromArgb(tp.Tone(tone))
+}
+
+// OklchTones returns the OKLCH coordinates of every tone of the TonalPalette, indexed by tone
+// from 0 to 100.
+func (tp *TonalPalette) OklchTones() [][]float64 {
+	tones := make([][]float64, 101)
+	for tone := range tones {
+		tones[tone] = tp.Oklch(float64(tone))
+	}
+	return tones
+}
+
+// GetHct returns the HCT color with the specified tone, sharing the cache of Tone.
 func (tp *TonalPalette) GetHct(tone float64) *hct.Hct {
-	return hct.NewHct(tp.hue, tp.chroma, tone)
+	color := tp.cachedHct(tone)
+	return &color
 }
 
 // GetChroma returns the chroma of the TonalPalette.
@@ -102,7 +255,15 @@ func (tp *TonalPalette) GetHue() float64 {
 	return tp.hue
 }
 
-// GetKeyColor returns the key color of the TonalPalette.
+// GetKeyColor returns a copy of the key color of the TonalPalette; modifying it does not
+// affect the TonalPalette.
+//
+// The key color is computed on first use, so palettes that are only used for their tones
+// never run the search.
 func (tp *TonalPalette) GetKeyColor() *hct.Hct {
-	return tp.keyColor
+	tp.keyOnce.Do(func() {
+		tp.keyColor = createKeyColor(tp.hue, tp.chroma)
+	})
+	keyColor := *tp.keyColor
+	return &keyColor
 }
@@ -1,7 +1,11 @@
 package palettes
 
 import (
+	"github.com/gio-eui/md3-colors/hct"
+	colorUtils "github.com/gio-eui/md3-colors/utils/color"
 	"github.com/stretchr/testify/assert"
+	"math"
+	"sync"
 	"testing"
 )
 
@@ -22,3 +26,174 @@ func TestNewTonalPaletteFromInt(t *testing.T) {
 	assert.Equal(t, blue.Tone(95), 0xfff1efff)
 	assert.Equal(t, blue.Tone(100), 0xffffffff)
 }
+
+func TestTonalPaletteToneInGamut(t *testing.T) {
+	green := NewTonalPaletteFromHueChromaInGamut(142.0, 200.0, colorUtils.GamutDisplayP3)
+	assert.Equal(t, colorUtils.GamutDisplayP3, green.GetGamut())
+
+	rgb, fallback := green.ToneInGamut(80)
+	assert.Equal(t, green.Tone(80), fallback)
+	for _, component := range rgb {
+		assert.GreaterOrEqual(t, component, 0.0)
+		assert.LessOrEqual(t, component, 1.0)
+	}
+	wide := hct.Cam16FromLinrgbInGamut([3]float64{
+		colorUtils.GamutDisplayP3.Linearized(rgb[0]),
+		colorUtils.GamutDisplayP3.Linearized(rgb[1]),
+		colorUtils.GamutDisplayP3.Linearized(rgb[2]),
+	}, colorUtils.GamutDisplayP3)
+	assert.Greater(t, wide.GetChroma(), hct.NewHctFromInt(fallback).GetChroma())
+}
+
+func TestTonalPaletteOklchTones(t *testing.T) {
+	blue := NewTonalPaletteFromInt(0xFF0000FF)
+	tones := blue.OklchTones()
+
+	assert.Len(t, tones, 101)
+	assert.Equal(t, colorUtils.OklchFromArgb(blue.Tone(40)), tones[40])
+	assert.InDelta(t, 0.0, tones[0][0], 0.001)
+	assert.InDelta(t, 1.0, tones[100][0], 0.001)
+	for tone := 1; tone <= 100; tone++ {
+		assert.Greater(t, tones[tone][0], tones[tone-1][0])
+	}
+}
+
+func TestTonalPaletteGetKeyColorReturnsCopy(t *testing.T) {
+	palette := NewTonalPaletteFromInt(0xff6750a4)
+	keyColor := palette.GetKeyColor()
+	argb := keyColor.ToInt()
+
+	keyColor.SetTone(95)
+	assert.NotEqual(t, argb, keyColor.ToInt())
+	assert.Equal(t, argb, palette.GetKeyColor().ToInt())
+}
+
+func TestTonalPaletteFractionalTones(t *testing.T) {
+	palette := NewTonalPaletteFromInt(0xff6750a4)
+
+	assert.Equal(t, hct.NewHct(palette.GetHue(), palette.GetChroma(), 87.5).ToInt(), palette.Tone(87.5))
+	assert.Equal(t, palette.Tone(87.5), palette.GetHct(87.5).ToInt())
+	assert.Equal(t, palette.Tone(87.5), palette.Tone(87.501))
+	assert.Equal(t, palette.Tone(0), palette.Tone(-5))
+	assert.Equal(t, palette.Tone(100), palette.Tone(105))
+	assert.NotEqual(t, palette.Tone(17), palette.Tone(17.5))
+}
+
+func TestTonalPaletteToneCacheIsBounded(t *testing.T) {
+	palette := NewTonalPaletteFromInt(0xff6750a4)
+	for tone := 0.0; tone <= 100.0; tone += 0.25 {
+		palette.Tone(tone)
+	}
+	assert.LessOrEqual(t, len(palette.cache), toneCacheLimit)
+}
+
+func TestTonalPaletteToneRamp(t *testing.T) {
+	blue := NewTonalPaletteFromInt(0xFF0000FF)
+
+	assert.Equal(t, []int{0xff000000, 0xff5a64ff, 0xffffffff}, blue.ToneRamp(50))
+	assert.Equal(t, []int{0xff0001ac, 0xffe0e0ff}, blue.Tones(20, 90))
+
+	ramp := blue.ToneRamp(30)
+	assert.Len(t, ramp, 5)
+	assert.Equal(t, blue.Tone(90), ramp[3])
+	assert.Equal(t, 0xffffffff, ramp[4])
+	assert.Nil(t, blue.ToneRamp(0))
+	assert.Nil(t, blue.ToneRamp(-1))
+	assert.Nil(t, blue.ToneRamp(math.NaN()))
+	assert.Nil(t, blue.ToneRamp(math.Inf(-1)))
+
+	// Steps finer than the tone resolution are raised to 0.01.
+	fine := blue.ToneRamp(1e-9)
+	assert.Len(t, fine, 10001)
+	assert.Equal(t, blue.ToneRamp(0.01), fine)
+	assert.Equal(t, []int{0xff000000, 0xffffffff}, blue.ToneRamp(math.Inf(1)))
+}
+
+func BenchmarkNewTonalPaletteFromHueChroma(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		NewTonalPaletteFromHueChroma(float64(i%360), 48.0)
+	}
+}
+
+func TestKeyColorWithExactChromaAvailable(t *testing.T) {
+	// Requested chroma is exactly achievable at a certain tone.
+	palette := NewTonalPaletteFromHueChroma(50.0, 60.0)
+	result := palette.GetKeyColor()
+
+	assert.InDelta(t, 50.0, result.GetHue(), 10.0)
+	assert.InDelta(t, 60.0, result.GetChroma(), 0.5)
+	// Tone might vary, but should be within the range from 0 to 100.
+	assert.Greater(t, result.GetTone(), 0.0)
+	assert.Less(t, result.GetTone(), 100.0)
+}
+
+func TestKeyColorWithUnusuallyHighChroma(t *testing.T) {
+	// Requested chroma is above what is achievable. For Hue 149, chroma peak is 89.6 at
+	// Tone 87.9. The result key color's chroma should be close to the chroma peak.
+	palette := NewTonalPaletteFromHueChroma(149.0, 200.0)
+	result := palette.GetKeyColor()
+
+	assert.InDelta(t, 149.0, result.GetHue(), 10.0)
+	assert.Greater(t, result.GetChroma(), 89.0)
+	// Tone might vary, but should be within the range from 0 to 100.
+	assert.Greater(t, result.GetTone(), 0.0)
+	assert.Less(t, result.GetTone(), 100.0)
+}
+
+func TestKeyColorWithLowChroma(t *testing.T) {
+	// By definition, the key color should be the first tone, starting from Tone 50, matching
+	// the given hue and chroma. When requesting a very low chroma, the result should be
+	// pretty close to Tone 50, since most tones can produce a low chroma.
+	palette := NewTonalPaletteFromHueChroma(50.0, 3.0)
+	result := palette.GetKeyColor()
+
+	// Higher error tolerance for hue when the requested chroma is unusually low.
+	assert.InDelta(t, 50.0, result.GetHue(), 10.0)
+	assert.InDelta(t, 3.0, result.GetChroma(), 0.5)
+	assert.InDelta(t, 50.0, result.GetTone(), 0.5)
+}
+
+func TestGetKeyColorConcurrently(t *testing.T) {
+	palette := NewTonalPaletteFromHueChroma(50.0, 60.0)
+	keyColors := make([]int, 8)
+	var wg sync.WaitGroup
+	for i := range keyColors {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			keyColors[i] = palette.GetKeyColor().ToInt()
+		}(i)
+	}
+	wg.Wait()
+	for _, argb := range keyColors {
+		assert.Equal(t, keyColors[0], argb)
+	}
+}
+
+func TestToneConcurrently(t *testing.T) {
+	palette := NewTonalPaletteFromHueChromaInGamut(50.0, 60.0, colorUtils.GamutDisplayP3)
+	expected := NewTonalPaletteFromHueChroma(50.0, 60.0).ToneRamp(0.25)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// 401 tones overflow the cache, which is cleared while other goroutines use it.
+			assert.Equal(t, expected, palette.ToneRamp(0.25))
+			for tone := 0.0; tone <= 100.0; tone += 12.5 {
+				palette.ToneInGamut(tone)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// BenchmarkTonalPaletteGetKeyColor measures the total cost of a palette with a key color:
+// construction plus the first GetKeyColor, which runs the search.
+func BenchmarkTonalPaletteGetKeyColor(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		NewTonalPaletteFromHueChroma(float64(i%360), 48.0).GetKeyColor()
+	}
+}
@@ -0,0 +1,93 @@
+package palettes
+
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"github.com/gio-eui/md3-colors/hct"
+)
+
+// ToneTable holds the precomputed integer tones of a TonalPalette.
+//
+// Tone tables are usually generated by md3gen, so that applications can build their palettes
+// at startup without running the HCT solver.
+type ToneTable struct {
+	Hue    float64
+	Chroma float64
+	// Tones holds the ARGB color of every integer tone, indexed by tone from 0 to 100.
+	Tones [101]int
+}
+
+// CoreToneTable holds the tone tables of every TonalPalette of a CorePalette.
+type CoreToneTable struct {
+	A1    ToneTable
+	A2    ToneTable
+	A3    ToneTable
+	N1    ToneTable
+	N2    ToneTable
+	Error ToneTable
+}
+
+// NewTonalPaletteFromToneTable creates a TonalPalette whose integer tones are read from
+// [table].
+//
+// Fractional tones, GetHct and GetKeyColor still use the HCT solver on first use. [table] is
+// shared with the TonalPalette and must not be modified afterwards.
+func NewTonalPaletteFromToneTable(table *ToneTable) *TonalPalette {
+	tp := NewTonalPaletteFromHueChroma(table.Hue, table.Chroma)
+	tp.tones = &table.Tones
+	return tp
+}
+
+// NewCorePaletteFromToneTable creates a CorePalette whose integer tones are read from [table].
+//
+// [table] is shared with the CorePalette and must not be modified afterwards.
+func NewCorePaletteFromToneTable(table *CoreToneTable) *CorePalette {
+	return &CorePalette{
+		A1:    NewTonalPaletteFromToneTable(&table.A1),
+		A2:    NewTonalPaletteFromToneTable(&table.A2),
+		A3:    NewTonalPaletteFromToneTable(&table.A3),
+		N1:    NewTonalPaletteFromToneTable(&table.N1),
+		N2:    NewTonalPaletteFromToneTable(&table.N2),
+		Error: NewTonalPaletteFromToneTable(&table.Error),
+	}
+}
+
+// ToneTable solves every integer tone of the TonalPalette into a ToneTable.
+//
+// Tones are always solved, even when the TonalPalette was created from a ToneTable, so the
+// result can be used to regenerate stale tables.
+func (tp *TonalPalette) ToneTable() ToneTable {
+	table := ToneTable{Hue: tp.hue, Chroma: tp.chroma}
+	for tone := range table.Tones {
+		table.Tones[tone] = hct.NewHct(tp.hue, tp.chroma, float64(tone)).ToInt()
+	}
+	return table
+}
+
+// NewCoreToneTable solves every integer tone of the core palette of [argb], or of its content
+// core palette if [isContent] is true, into a CoreToneTable.
+//
+// Precomputed tables are never read, so the result can be used to regenerate stale tables.
+func NewCoreToneTable(argb int, isContent bool) CoreToneTable {
+	cp := newSolvedCorePalette(argb, isContent)
+	return CoreToneTable{
+		A1:    cp.A1.ToneTable(),
+		A2:    cp.A2.ToneTable(),
+		A3:    cp.A3.ToneTable(),
+		N1:    cp.N1.ToneTable(),
+		N2:    cp.N2.ToneTable(),
+		Error: cp.Error.ToneTable(),
+	}
+}
@@ -0,0 +1,53 @@
+package palettes
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestSeedToneTablesAreCurrent(t *testing.T) {
+	require.NotEmpty(t, seedToneTables)
+	require.Len(t, contentSeedToneTables, len(seedToneTables))
+	for seed, table := range seedToneTables {
+		solved := NewCoreToneTable(seed, false)
+		assert.Equal(t, solved, *table, "seed %#x; run go generate", seed)
+	}
+	for seed, table := range contentSeedToneTables {
+		solved := NewCoreToneTable(seed, true)
+		assert.Equal(t, solved, *table, "content seed %#x; run go generate", seed)
+	}
+}
+
+func TestNewCorePaletteFromIntUsesSeedTables(t *testing.T) {
+	baseline := NewCorePaletteFromInt(0xff6750a4)
+	assert.Same(t, &seedToneTables[0xff6750a4].A1.Tones, baseline.A1.tones)
+	assert.Equal(t, 0xff6750a4, baseline.A1.Tone(40))
+
+	content := NewContentCorePaletteFromInt(0xff6750a4)
+	assert.Same(t, &contentSeedToneTables[0xff6750a4].A1.Tones, content.A1.tones)
+}
+
+func TestNewTonalPaletteFromToneTable(t *testing.T) {
+	solved := NewTonalPaletteFromHueChroma(212.0, 36.0)
+	table := solved.ToneTable()
+	palette := NewTonalPaletteFromToneTable(&table)
+
+	assert.Equal(t, solved.GetHue(), palette.GetHue())
+	assert.Equal(t, solved.GetChroma(), palette.GetChroma())
+	for _, tone := range []float64{0, 10, 40, 40.001, 99, 100} {
+		assert.Equal(t, solved.Tone(tone), palette.Tone(tone), "tone %v", tone)
+	}
+	assert.Empty(t, palette.cache)
+	assert.Equal(t, solved.Tone(62.5), palette.Tone(62.5))
+
+	assert.Equal(t, solved.Tone(62.5), palette.GetHct(62.5).ToInt())
+}
+
+func BenchmarkNewCorePaletteFromToneTable(b *testing.B) {
+	table := NewCoreToneTable(0xff00796b, false)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		NewCorePaletteFromToneTable(&table).A1.Tone(40)
+	}
+}
@@ -0,0 +1,43 @@
+package quantize
+
+import (
+	"github.com/gio-eui/md3-colors/internal/golden"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+// The golden vectors in testdata are transcribed from the test suites of Material Color
+// Utilities, so that any divergence from the reference implementations fails go test.
+
+func TestConformanceQuantizers(t *testing.T) {
+	var vectors struct {
+		MaxColors int `json:"maxColors"`
+		Cases     []struct {
+			Name   string        `json:"name"`
+			Pixels []golden.Argb `json:"pixels"`
+			Colors []golden.Argb `json:"colors"`
+		} `json:"cases"`
+	}
+	golden.Load(t, "quantize.json", &vectors)
+	require.NotEmpty(t, vectors.Cases)
+
+	for _, c := range vectors.Cases {
+		pixels := make([]int, len(c.Pixels))
+		for i, pixel := range c.Pixels {
+			pixels[i] = int(pixel)
+		}
+		colors := make([]int, len(c.Colors))
+		for i, color := range c.Colors {
+			colors[i] = int(color)
+		}
+
+		assert.ElementsMatch(t, colors, Wu(pixels, vectors.MaxColors), "wu %s", c.Name)
+		celebi := Celebi(pixels, vectors.MaxColors)
+		keys := make([]int, 0, len(celebi))
+		for color := range celebi {
+			keys = append(keys, color)
+		}
+		assert.ElementsMatch(t, colors, keys, "celebi %s", c.Name)
+	}
+}
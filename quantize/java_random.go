@@ -0,0 +1,56 @@
+package quantize
+
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// javaRandom is the linear congruential generator of java.util.Random, so that the results of
+// Wsmeans match the reference implementation.
+type javaRandom struct {
+	seed int64
+}
+
+const (
+	javaRandomMultiplier = 0x5DEECE66D
+	javaRandomMask       = (1 << 48) - 1
+)
+
+// newJavaRandom creates a javaRandom with the given seed.
+func newJavaRandom(seed int64) *javaRandom {
+	return &javaRandom{seed: (seed ^ javaRandomMultiplier) & javaRandomMask}
+}
+
+// next returns the next pseudorandom number with the given number of random bits.
+func (r *javaRandom) next(bits uint) int32 {
+	r.seed = (r.seed*javaRandomMultiplier + 0xB) & javaRandomMask
+	return int32(r.seed >> (48 - bits))
+}
+
+// nextInt returns a pseudorandom integer between 0, inclusive, and [bound], exclusive.
+func (r *javaRandom) nextInt(bound int32) int32 {
+	if bound&-bound == bound {
+		return int32((int64(bound) * int64(r.next(31))) >> 31)
+	}
+	for {
+		bits := r.next(31)
+		value := bits % bound
+		if bits-value+(bound-1) >= 0 {
+			return value
+		}
+	}
+}
+
+// nextDouble returns a pseudorandom number between 0.0, inclusive, and 1.0, exclusive.
+func (r *javaRandom) nextDouble() float64 {
+	return float64(int64(r.next(26))<<27+int64(r.next(27))) * (1.0 / (1 << 53))
+}
@@ -0,0 +1,39 @@
+package quantize
+
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	colorUtils "github.com/gio-eui/md3-colors/utils/color"
+)
+
+// labFromInt converts an ARGB color to a point in L*a*b* space.
+func labFromInt(argb int) [3]float64 {
+	lab := colorUtils.LabFromArgb(argb)
+	return [3]float64{lab[0], lab[1], lab[2]}
+}
+
+// intFromLab converts a point in L*a*b* space to an ARGB color.
+func intFromLab(lab [3]float64) int {
+	return colorUtils.ArgbFromLab(lab[0], lab[1], lab[2])
+}
+
+// labDistance returns the square of the Euclidean distance between two points in L*a*b* space;
+// it is only used for comparisons.
+func labDistance(one, two [3]float64) float64 {
+	dL := one[0] - two[0]
+	dA := one[1] - two[1]
+	dB := one[2] - two[2]
+	return dL*dL + dA*dA + dB*dB
+}
@@ -0,0 +1,60 @@
+package quantize
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+const (
+	red   = 0xffff0000
+	green = 0xff00ff00
+	blue  = 0xff0000ff
+)
+
+func TestMap(t *testing.T) {
+	assert.Equal(t, map[int]int{red: 2, blue: 1}, Map([]int{red, blue, red, 0x80ff0000}))
+}
+
+func TestWu(t *testing.T) {
+	assert.Equal(t, []int{red}, Wu([]int{red}, 128))
+	assert.Equal(t, []int{blue}, Wu([]int{blue, blue, blue, blue, blue}, 128))
+	assert.Len(t, Wu([]int{red, red, green, green, green}, 256), 2)
+	assert.ElementsMatch(t, []int{red, green, blue}, Wu([]int{red, green, blue}, 128))
+	assert.Len(t, Wu([]int{red, green, blue}, 2), 2)
+	assert.Empty(t, Wu(nil, 128))
+}
+
+func TestWsmeans(t *testing.T) {
+	assert.Equal(t, map[int]int{red: 1}, Wsmeans([]int{red}, nil, 128))
+	assert.Equal(t, map[int]int{blue: 5}, Wsmeans([]int{blue, blue, blue, blue, blue}, nil, 128))
+	assert.Equal(t, map[int]int{red: 2, green: 3}, Wsmeans([]int{red, red, green, green, green}, []int{red, green}, 256))
+	assert.Empty(t, Wsmeans(nil, nil, 128))
+}
+
+func TestCelebi(t *testing.T) {
+	assert.Equal(t, map[int]int{red: 1, green: 1, blue: 1}, Celebi([]int{red, green, blue}, 128))
+
+	// Two noisy clusters around a teal and an orange.
+	var pixels []int
+	for i := 0; i < 400; i++ {
+		noise := (i * 7919) % 16
+		pixels = append(pixels, 0xff00796b+noise<<8, 0xffff9800+noise)
+	}
+	result := Celebi(pixels, 2)
+	require.Len(t, result, 2)
+	total := 0
+	for _, count := range result {
+		total += count
+	}
+	assert.Equal(t, len(pixels), total)
+	assert.Equal(t, result, Celebi(pixels, 2))
+}
+
+func TestJavaRandom(t *testing.T) {
+	// Reference values of new java.util.Random(42).
+	random := newJavaRandom(42)
+	assert.Equal(t, int32(0), random.nextInt(10))
+	assert.Equal(t, int32(3), random.nextInt(10))
+	assert.InDelta(t, 0.7275636800328681, newJavaRandom(42).nextDouble(), 1e-15)
+}
@@ -0,0 +1,29 @@
+package quantize
+
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Celebi quantizes [pixels] to at most [maxColors] colors, and returns the colors along with
+// the number of pixels they represent.
+//
+// An image quantizer that improves on the quality of a standard K-Means algorithm by setting
+// the K-Means initial state to the output of a Wu quantizer, instead of random centroids.
+// Improves on speed by several optimizations, as implemented in Wsmeans, or Weighted Square
+// Means, K-Means with those optimizations.
+//
+// This algorithm was designed by M. Emre Celebi, and was found in their 2011 paper, Improving
+// the Performance of K-Means for Color Quantization. https://arxiv.org/abs/1101.0395
+func Celebi(pixels []int, maxColors int) map[int]int {
+	return Wsmeans(pixels, Wu(pixels, maxColors), maxColors)
+}
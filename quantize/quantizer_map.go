@@ -0,0 +1,32 @@
+package quantize
+
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	colorUtils "github.com/gio-eui/md3-colors/utils/color"
+)
+
+// Map creates a map of colors to their number of occurrences in [pixels], ignoring pixels that
+// are not opaque.
+func Map(pixels []int) map[int]int {
+	colorToCount := make(map[int]int)
+	for _, pixel := range pixels {
+		if colorUtils.AlphaFromArgb(pixel) < 255 {
+			continue
+		}
+		colorToCount[pixel]++
+	}
+	return colorToCount
+}
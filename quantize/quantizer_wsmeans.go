@@ -0,0 +1,200 @@
+package quantize
+
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"math"
+	"sort"
+)
+
+const (
+	wsmeansMaxIterations       = 10
+	wsmeansMinMovementDistance = 3.0
+)
+
+// wsmeansDistance is the distance to the cluster of index [index].
+type wsmeansDistance struct {
+	index    int
+	distance float64
+}
+
+// Wsmeans quantizes [inputPixels] to at most [maxColors] colors, starting from the cluster
+// centers [startingClusters], and returns the colors along with the number of pixels they
+// represent.
+//
+// An image quantizer that improves on the speed of a standard K-Means algorithm by
+// implementing several optimizations, including deduping identical pixels and a triangle
+// inequality rule that reduces the number of comparisons needed to identify which cluster a
+// point should be moved to.
+//
+// Wsmeans stands for Weighted Square Means.
+//
+// This algorithm was designed by M. Emre Celebi, and was found in their 2011 paper, Improving
+// the Performance of K-Means for Color Quantization. https://arxiv.org/abs/1101.0395
+func Wsmeans(inputPixels []int, startingClusters []int, maxColors int) map[int]int {
+	// Uses a seeded random number generator to ensure consistent results.
+	random := newJavaRandom(0x42688)
+
+	pixelToCount := make(map[int]int)
+	points := make([][3]float64, 0, len(inputPixels))
+	pixels := make([]int, 0, len(inputPixels))
+	for _, inputPixel := range inputPixels {
+		if _, ok := pixelToCount[inputPixel]; !ok {
+			points = append(points, labFromInt(inputPixel))
+			pixels = append(pixels, inputPixel)
+		}
+		pixelToCount[inputPixel]++
+	}
+	pointCount := len(points)
+
+	counts := make([]int, pointCount)
+	for i := range counts {
+		counts[i] = pixelToCount[pixels[i]]
+	}
+
+	clusterCount := minInt(maxColors, pointCount)
+	if len(startingClusters) != 0 {
+		clusterCount = minInt(clusterCount, len(startingClusters))
+	}
+	if clusterCount < 1 {
+		return map[int]int{}
+	}
+
+	clusters := make([][3]float64, clusterCount)
+	clustersCreated := 0
+	for i := 0; i < clusterCount && i < len(startingClusters); i++ {
+		clusters[i] = labFromInt(startingClusters[i])
+		clustersCreated++
+	}
+
+	additionalClustersNeeded := clusterCount - clustersCreated
+	if len(startingClusters) == 0 && additionalClustersNeeded > 0 {
+		for i := 0; i < additionalClustersNeeded; i++ {
+			l := random.nextDouble() * 100.0
+			a := random.nextDouble()*(100.0-(-100.0)+1) + -100
+			b := random.nextDouble()*(100.0-(-100.0)+1) + -100
+			clusters[clustersCreated+i] = [3]float64{l, a, b}
+		}
+	}
+
+	clusterIndices := make([]int, pointCount)
+	for i := range clusterIndices {
+		clusterIndices[i] = int(random.nextInt(int32(clusterCount)))
+	}
+
+	distanceToIndexMatrix := make([][]wsmeansDistance, clusterCount)
+	for i := 0; i < clusterCount; i++ {
+		distanceToIndexMatrix[i] = make([]wsmeansDistance, clusterCount)
+		for j := range distanceToIndexMatrix[i] {
+			distanceToIndexMatrix[i][j] = wsmeansDistance{index: -1, distance: -1}
+		}
+	}
+
+	pixelCountSums := make([]int, clusterCount)
+	for iteration := 0; iteration < wsmeansMaxIterations; iteration++ {
+		for i := 0; i < clusterCount; i++ {
+			for j := i + 1; j < clusterCount; j++ {
+				distance := labDistance(clusters[i], clusters[j])
+				distanceToIndexMatrix[j][i] = wsmeansDistance{index: i, distance: distance}
+				distanceToIndexMatrix[i][j] = wsmeansDistance{index: j, distance: distance}
+			}
+			row := distanceToIndexMatrix[i]
+			sort.SliceStable(row, func(a, b int) bool {
+				return row[a].distance < row[b].distance
+			})
+		}
+
+		pointsMoved := 0
+		for i := 0; i < pointCount; i++ {
+			point := points[i]
+			previousClusterIndex := clusterIndices[i]
+			previousDistance := labDistance(point, clusters[previousClusterIndex])
+
+			minimumDistance := previousDistance
+			newClusterIndex := -1
+			for j := 0; j < clusterCount; j++ {
+				if distanceToIndexMatrix[previousClusterIndex][j].distance >= 4*previousDistance {
+					continue
+				}
+				distance := labDistance(point, clusters[j])
+				if distance < minimumDistance {
+					minimumDistance = distance
+					newClusterIndex = j
+				}
+			}
+			if newClusterIndex != -1 {
+				distanceChange := math.Abs(math.Sqrt(minimumDistance) - math.Sqrt(previousDistance))
+				if distanceChange > wsmeansMinMovementDistance {
+					pointsMoved++
+					clusterIndices[i] = newClusterIndex
+				}
+			}
+		}
+
+		if pointsMoved == 0 && iteration != 0 {
+			break
+		}
+
+		componentASums := make([]float64, clusterCount)
+		componentBSums := make([]float64, clusterCount)
+		componentCSums := make([]float64, clusterCount)
+		for i := range pixelCountSums {
+			pixelCountSums[i] = 0
+		}
+		for i := 0; i < pointCount; i++ {
+			clusterIndex := clusterIndices[i]
+			point := points[i]
+			count := counts[i]
+			pixelCountSums[clusterIndex] += count
+			componentASums[clusterIndex] += point[0] * float64(count)
+			componentBSums[clusterIndex] += point[1] * float64(count)
+			componentCSums[clusterIndex] += point[2] * float64(count)
+		}
+
+		for i := 0; i < clusterCount; i++ {
+			count := pixelCountSums[i]
+			if count == 0 {
+				clusters[i] = [3]float64{}
+				continue
+			}
+			clusters[i] = [3]float64{
+				componentASums[i] / float64(count),
+				componentBSums[i] / float64(count),
+				componentCSums[i] / float64(count),
+			}
+		}
+	}
+
+	argbToPopulation := make(map[int]int)
+	for i := 0; i < clusterCount; i++ {
+		count := pixelCountSums[i]
+		if count == 0 {
+			continue
+		}
+		possibleNewCluster := intFromLab(clusters[i])
+		if _, ok := argbToPopulation[possibleNewCluster]; ok {
+			continue
+		}
+		argbToPopulation[possibleNewCluster] = count
+	}
+	return argbToPopulation
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,345 @@
+package quantize
+
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	colorUtils "github.com/gio-eui/md3-colors/utils/color"
+)
+
+// Wu quantizes [pixels] to at most [maxColors] colors, and returns the colors.
+//
+// An image quantizer that divides the image's pixels into clusters by recursively cutting an
+// RGB cube, based on the weight of pixels in each area of the cube.
+//
+// The algorithm was described by Xiaolin Wu in Graphic Gems II, published in 1991.
+func Wu(pixels []int, maxColors int) []int {
+	q := &quantizerWu{}
+	q.constructHistogram(Map(pixels))
+	q.createMoments()
+	resultCount := q.createBoxes(maxColors)
+	return q.createResult(resultCount)
+}
+
+const (
+	// A histogram of all the input colors is constructed. It has the shape of a cube. The cube
+	// would be too large if it contained all 16 million colors: historical best practice is to
+	// use 5 bits of the 8 in each channel, reducing the histogram to a volume of ~32,000.
+	wuIndexBits  = 5
+	wuIndexCount = 33    // ((1 << wuIndexBits) + 1)
+	wuTotalSize  = 35937 // wuIndexCount * wuIndexCount * wuIndexCount
+)
+
+type wuDirection int
+
+const (
+	wuRed wuDirection = iota
+	wuGreen
+	wuBlue
+)
+
+// wuBox keeps track of the state of each box created as the Wu quantization algorithm
+// progresses through dividing the image's pixels as plotted in RGB.
+type wuBox struct {
+	r0, r1 int
+	g0, g1 int
+	b0, b1 int
+	vol    int
+}
+
+type quantizerWu struct {
+	weights  []int
+	momentsR []int
+	momentsG []int
+	momentsB []int
+	moments  []float64
+	cubes    []wuBox
+}
+
+func wuIndex(r, g, b int) int {
+	return (r << (wuIndexBits * 2)) + (r << (wuIndexBits + 1)) + r + (g << wuIndexBits) + g + b
+}
+
+func (q *quantizerWu) constructHistogram(pixels map[int]int) {
+	q.weights = make([]int, wuTotalSize)
+	q.momentsR = make([]int, wuTotalSize)
+	q.momentsG = make([]int, wuTotalSize)
+	q.momentsB = make([]int, wuTotalSize)
+	q.moments = make([]float64, wuTotalSize)
+
+	for pixel, count := range pixels {
+		red := colorUtils.RedFromArgb(pixel)
+		green := colorUtils.GreenFromArgb(pixel)
+		blue := colorUtils.BlueFromArgb(pixel)
+		bitsToRemove := 8 - wuIndexBits
+		iR := (red >> bitsToRemove) + 1
+		iG := (green >> bitsToRemove) + 1
+		iB := (blue >> bitsToRemove) + 1
+		index := wuIndex(iR, iG, iB)
+		q.weights[index] += count
+		q.momentsR[index] += red * count
+		q.momentsG[index] += green * count
+		q.momentsB[index] += blue * count
+		q.moments[index] += float64(count * (red*red + green*green + blue*blue))
+	}
+}
+
+func (q *quantizerWu) createMoments() {
+	for r := 1; r < wuIndexCount; r++ {
+		var area, areaR, areaG, areaB [wuIndexCount]int
+		var area2 [wuIndexCount]float64
+
+		for g := 1; g < wuIndexCount; g++ {
+			line, lineR, lineG, lineB := 0, 0, 0, 0
+			line2 := 0.0
+			for b := 1; b < wuIndexCount; b++ {
+				index := wuIndex(r, g, b)
+				line += q.weights[index]
+				lineR += q.momentsR[index]
+				lineG += q.momentsG[index]
+				lineB += q.momentsB[index]
+				line2 += q.moments[index]
+
+				area[b] += line
+				areaR[b] += lineR
+				areaG[b] += lineG
+				areaB[b] += lineB
+				area2[b] += line2
+
+				previousIndex := wuIndex(r-1, g, b)
+				q.weights[index] = q.weights[previousIndex] + area[b]
+				q.momentsR[index] = q.momentsR[previousIndex] + areaR[b]
+				q.momentsG[index] = q.momentsG[previousIndex] + areaG[b]
+				q.momentsB[index] = q.momentsB[previousIndex] + areaB[b]
+				q.moments[index] = q.moments[previousIndex] + area2[b]
+			}
+		}
+	}
+}
+
+// createBoxes cuts the histogram into at most [maxColorCount] boxes, and returns the number
+// of boxes created.
+func (q *quantizerWu) createBoxes(maxColorCount int) int {
+	if maxColorCount < 1 {
+		return 0
+	}
+	q.cubes = make([]wuBox, maxColorCount)
+	volumeVariance := make([]float64, maxColorCount)
+	q.cubes[0].r1 = wuIndexCount - 1
+	q.cubes[0].g1 = wuIndexCount - 1
+	q.cubes[0].b1 = wuIndexCount - 1
+
+	generatedColorCount := maxColorCount
+	next := 0
+	for i := 1; i < maxColorCount; i++ {
+		if q.cut(&q.cubes[next], &q.cubes[i]) {
+			volumeVariance[next] = 0.0
+			if q.cubes[next].vol > 1 {
+				volumeVariance[next] = q.variance(&q.cubes[next])
+			}
+			volumeVariance[i] = 0.0
+			if q.cubes[i].vol > 1 {
+				volumeVariance[i] = q.variance(&q.cubes[i])
+			}
+		} else {
+			volumeVariance[next] = 0.0
+			i--
+		}
+
+		next = 0
+		temp := volumeVariance[0]
+		for j := 1; j <= i; j++ {
+			if volumeVariance[j] > temp {
+				temp = volumeVariance[j]
+				next = j
+			}
+		}
+		if temp <= 0.0 {
+			generatedColorCount = i + 1
+			break
+		}
+	}
+	return generatedColorCount
+}
+
+func (q *quantizerWu) createResult(colorCount int) []int {
+	var colors []int
+	for i := 0; i < colorCount; i++ {
+		cube := &q.cubes[i]
+		weight := wuVolume(cube, q.weights)
+		if weight > 0 {
+			r := wuVolume(cube, q.momentsR) / weight
+			g := wuVolume(cube, q.momentsG) / weight
+			b := wuVolume(cube, q.momentsB) / weight
+			colors = append(colors, colorUtils.ArgbFromRgb(r&0xff, g&0xff, b&0xff))
+		}
+	}
+	return colors
+}
+
+func (q *quantizerWu) variance(cube *wuBox) float64 {
+	dr := wuVolume(cube, q.momentsR)
+	dg := wuVolume(cube, q.momentsG)
+	db := wuVolume(cube, q.momentsB)
+	xx := q.moments[wuIndex(cube.r1, cube.g1, cube.b1)] -
+		q.moments[wuIndex(cube.r1, cube.g1, cube.b0)] -
+		q.moments[wuIndex(cube.r1, cube.g0, cube.b1)] +
+		q.moments[wuIndex(cube.r1, cube.g0, cube.b0)] -
+		q.moments[wuIndex(cube.r0, cube.g1, cube.b1)] +
+		q.moments[wuIndex(cube.r0, cube.g1, cube.b0)] +
+		q.moments[wuIndex(cube.r0, cube.g0, cube.b1)] -
+		q.moments[wuIndex(cube.r0, cube.g0, cube.b0)]
+
+	hypotenuse := dr*dr + dg*dg + db*db
+	volume := wuVolume(cube, q.weights)
+	return xx - float64(hypotenuse)/float64(volume)
+}
+
+func (q *quantizerWu) cut(one, two *wuBox) bool {
+	wholeR := wuVolume(one, q.momentsR)
+	wholeG := wuVolume(one, q.momentsG)
+	wholeB := wuVolume(one, q.momentsB)
+	wholeW := wuVolume(one, q.weights)
+
+	maxRCut, maxR := q.maximize(one, wuRed, one.r0+1, one.r1, wholeR, wholeG, wholeB, wholeW)
+	maxGCut, maxG := q.maximize(one, wuGreen, one.g0+1, one.g1, wholeR, wholeG, wholeB, wholeW)
+	maxBCut, maxB := q.maximize(one, wuBlue, one.b0+1, one.b1, wholeR, wholeG, wholeB, wholeW)
+
+	var cutDirection wuDirection
+	if maxR >= maxG && maxR >= maxB {
+		if maxRCut < 0 {
+			return false
+		}
+		cutDirection = wuRed
+	} else if maxG >= maxR && maxG >= maxB {
+		cutDirection = wuGreen
+	} else {
+		cutDirection = wuBlue
+	}
+
+	two.r1 = one.r1
+	two.g1 = one.g1
+	two.b1 = one.b1
+
+	switch cutDirection {
+	case wuRed:
+		one.r1 = maxRCut
+		two.r0 = one.r1
+		two.g0 = one.g0
+		two.b0 = one.b0
+	case wuGreen:
+		one.g1 = maxGCut
+		two.r0 = one.r0
+		two.g0 = one.g1
+		two.b0 = one.b0
+	case wuBlue:
+		one.b1 = maxBCut
+		two.r0 = one.r0
+		two.g0 = one.g0
+		two.b0 = one.b1
+	}
+
+	one.vol = (one.r1 - one.r0) * (one.g1 - one.g0) * (one.b1 - one.b0)
+	two.vol = (two.r1 - two.r0) * (two.g1 - two.g0) * (two.b1 - two.b0)
+	return true
+}
+
+// maximize returns the position of the best cut of [cube] in [direction], or -1 if there is
+// none, and the variance it achieves.
+func (q *quantizerWu) maximize(cube *wuBox, direction wuDirection, first, last, wholeR, wholeG, wholeB, wholeW int) (int, float64) {
+	bottomR := wuBottom(cube, direction, q.momentsR)
+	bottomG := wuBottom(cube, direction, q.momentsG)
+	bottomB := wuBottom(cube, direction, q.momentsB)
+	bottomW := wuBottom(cube, direction, q.weights)
+
+	max := 0.0
+	cut := -1
+	for i := first; i < last; i++ {
+		halfR := bottomR + wuTop(cube, direction, i, q.momentsR)
+		halfG := bottomG + wuTop(cube, direction, i, q.momentsG)
+		halfB := bottomB + wuTop(cube, direction, i, q.momentsB)
+		halfW := bottomW + wuTop(cube, direction, i, q.weights)
+		if halfW == 0 {
+			continue
+		}
+
+		temp := float64(halfR*halfR+halfG*halfG+halfB*halfB) / float64(halfW)
+
+		halfR = wholeR - halfR
+		halfG = wholeG - halfG
+		halfB = wholeB - halfB
+		halfW = wholeW - halfW
+		if halfW == 0 {
+			continue
+		}
+
+		temp += float64(halfR*halfR+halfG*halfG+halfB*halfB) / float64(halfW)
+		if temp > max {
+			max = temp
+			cut = i
+		}
+	}
+	return cut, max
+}
+
+func wuVolume(cube *wuBox, moment []int) int {
+	return moment[wuIndex(cube.r1, cube.g1, cube.b1)] -
+		moment[wuIndex(cube.r1, cube.g1, cube.b0)] -
+		moment[wuIndex(cube.r1, cube.g0, cube.b1)] +
+		moment[wuIndex(cube.r1, cube.g0, cube.b0)] -
+		moment[wuIndex(cube.r0, cube.g1, cube.b1)] +
+		moment[wuIndex(cube.r0, cube.g1, cube.b0)] +
+		moment[wuIndex(cube.r0, cube.g0, cube.b1)] -
+		moment[wuIndex(cube.r0, cube.g0, cube.b0)]
+}
+
+func wuBottom(cube *wuBox, direction wuDirection, moment []int) int {
+	switch direction {
+	case wuRed:
+		return -moment[wuIndex(cube.r0, cube.g1, cube.b1)] +
+			moment[wuIndex(cube.r0, cube.g1, cube.b0)] +
+			moment[wuIndex(cube.r0, cube.g0, cube.b1)] -
+			moment[wuIndex(cube.r0, cube.g0, cube.b0)]
+	case wuGreen:
+		return -moment[wuIndex(cube.r1, cube.g0, cube.b1)] +
+			moment[wuIndex(cube.r1, cube.g0, cube.b0)] +
+			moment[wuIndex(cube.r0, cube.g0, cube.b1)] -
+			moment[wuIndex(cube.r0, cube.g0, cube.b0)]
+	default:
+		return -moment[wuIndex(cube.r1, cube.g1, cube.b0)] +
+			moment[wuIndex(cube.r1, cube.g0, cube.b0)] +
+			moment[wuIndex(cube.r0, cube.g1, cube.b0)] -
+			moment[wuIndex(cube.r0, cube.g0, cube.b0)]
+	}
+}
+
+func wuTop(cube *wuBox, direction wuDirection, position int, moment []int) int {
+	switch direction {
+	case wuRed:
+		return moment[wuIndex(position, cube.g1, cube.b1)] -
+			moment[wuIndex(position, cube.g1, cube.b0)] -
+			moment[wuIndex(position, cube.g0, cube.b1)] +
+			moment[wuIndex(position, cube.g0, cube.b0)]
+	case wuGreen:
+		return moment[wuIndex(cube.r1, position, cube.b1)] -
+			moment[wuIndex(cube.r1, position, cube.b0)] -
+			moment[wuIndex(cube.r0, position, cube.b1)] +
+			moment[wuIndex(cube.r0, position, cube.b0)]
+	default:
+		return moment[wuIndex(cube.r1, cube.g1, position)] -
+			moment[wuIndex(cube.r1, cube.g0, position)] -
+			moment[wuIndex(cube.r0, cube.g1, position)] +
+			moment[wuIndex(cube.r0, cube.g0, position)]
+	}
+}
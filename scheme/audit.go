@@ -0,0 +1,155 @@
+package scheme
+
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"fmt"
+	"github.com/gio-eui/md3-colors/contrast"
+	"math"
+)
+
+// Metric selects how Audit measures the contrast of a role pair.
+type Metric int
+
+const (
+	// MetricWCAG measures the contrast ratio of WCAG 2, from 1.0 to 21.0.
+	MetricWCAG Metric = iota
+	// MetricAPCA measures the absolute lightness contrast Lc of APCA, from 0.0 to about 108.
+	// APCA penalizes light text on dark backgrounds less than WCAG 2 does.
+	MetricAPCA
+)
+
+// String returns the name of the metric.
+func (m Metric) String() string {
+	switch m {
+	case MetricAPCA:
+		return "apca"
+	default:
+		return "wcag"
+	}
+}
+
+// ParseMetric returns the Metric named [name], as returned by Metric.String.
+func ParseMetric(name string) (Metric, error) {
+	for _, m := range []Metric{MetricWCAG, MetricAPCA} {
+		if m.String() == name {
+			return m, nil
+		}
+	}
+	return MetricWCAG, fmt.Errorf("unknown contrast metric %q", name)
+}
+
+// DefaultMinimum returns the contrast required for body text with the metric: a ratio of 4.5,
+// or an Lc of 60.
+func (m Metric) DefaultMinimum() float64 {
+	if m == MetricAPCA {
+		return 60.0
+	}
+	return 4.5
+}
+
+// Measure returns the contrast of [foreground] drawn on [background], both in ARGB format.
+func (m Metric) Measure(foreground, background int) float64 {
+	if m == MetricAPCA {
+		return math.Abs(contrast.LcOfArgbs(foreground, background))
+	}
+	return contrast.RatioOfArgbs(foreground, background)
+}
+
+// Pair is a content role drawn on a container role, such as "onPrimary" on "primary".
+type Pair struct {
+	Foreground string
+	Background string
+}
+
+// Pairs lists the role pairs of a Scheme meant to be drawn on each other.
+var Pairs = []Pair{
+	{"onPrimary", "primary"},
+	{"onPrimaryContainer", "primaryContainer"},
+	{"onSecondary", "secondary"},
+	{"onSecondaryContainer", "secondaryContainer"},
+	{"onTertiary", "tertiary"},
+	{"onTertiaryContainer", "tertiaryContainer"},
+	{"onError", "error"},
+	{"onErrorContainer", "errorContainer"},
+	{"onBackground", "background"},
+	{"onSurface", "surface"},
+	{"onSurfaceVariant", "surfaceVariant"},
+	{"inverseOnSurface", "inverseSurface"},
+	{"inversePrimary", "inverseSurface"},
+	{"onSurface", "surfaceDim"},
+	{"onSurface", "surfaceBright"},
+	{"onSurface", "surfaceContainerLowest"},
+	{"onSurface", "surfaceContainerLow"},
+	{"onSurface", "surfaceContainer"},
+	{"onSurface", "surfaceContainerHigh"},
+	{"onSurface", "surfaceContainerHighest"},
+	{"onPrimaryFixed", "primaryFixed"},
+	{"onPrimaryFixedVariant", "primaryFixed"},
+	{"onSecondaryFixed", "secondaryFixed"},
+	{"onSecondaryFixedVariant", "secondaryFixed"},
+	{"onTertiaryFixed", "tertiaryFixed"},
+	{"onTertiaryFixedVariant", "tertiaryFixed"},
+}
+
+// Finding is the contrast of a role pair of a Scheme, as measured by Audit.
+type Finding struct {
+	Pair
+	ForegroundArgb int
+	BackgroundArgb int
+	Metric         Metric
+	Contrast       float64
+	Minimum        float64
+}
+
+// Pass tells whether the contrast of the Finding reaches its minimum.
+func (f Finding) Pass() bool {
+	return f.Contrast >= f.Minimum
+}
+
+// String describes the Finding, such as "onPrimary on primary: wcag 6.44 >= 4.50".
+func (f Finding) String() string {
+	op := ">="
+	if !f.Pass() {
+		op = "<"
+	}
+	return fmt.Sprintf("%s on %s: %s %.2f %s %.2f", f.Foreground, f.Background, f.Metric, f.Contrast, op, f.Minimum)
+}
+
+// Audit measures the contrast of every pair of Pairs with [metric], and compares it with
+// [minimum], such as metric.DefaultMinimum(). It returns an error if a pair names a role the
+// Scheme does not have.
+func (s *Scheme) Audit(metric Metric, minimum float64) ([]Finding, error) {
+	findings := make([]Finding, 0, len(Pairs))
+	for _, pair := range Pairs {
+		foreground, ok := s.Role(pair.Foreground)
+		if !ok {
+			return nil, fmt.Errorf("unknown scheme role %q", pair.Foreground)
+		}
+		background, ok := s.Role(pair.Background)
+		if !ok {
+			return nil, fmt.Errorf("unknown scheme role %q", pair.Background)
+		}
+		findings = append(findings, Finding{
+			Pair:           pair,
+			ForegroundArgb: foreground,
+			BackgroundArgb: background,
+			Metric:         metric,
+			Contrast:       metric.Measure(foreground, background),
+			Minimum:        minimum,
+		})
+	}
+	return findings, nil
+}
@@ -0,0 +1,73 @@
+package scheme
+
+import (
+	"github.com/gio-eui/md3-colors/contrast"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestPairsNameRoles(t *testing.T) {
+	s := NewLightScheme(0xff6750a4)
+	for _, pair := range Pairs {
+		_, ok := s.Role(pair.Foreground)
+		assert.True(t, ok, pair.Foreground)
+		_, ok = s.Role(pair.Background)
+		assert.True(t, ok, pair.Background)
+	}
+}
+
+func TestAuditWCAG(t *testing.T) {
+	for _, s := range []*Scheme{NewLightScheme(0xff6750a4), NewDarkScheme(0xff6750a4)} {
+		findings, err := s.Audit(MetricWCAG, MetricWCAG.DefaultMinimum())
+		require.NoError(t, err)
+		require.Len(t, findings, len(Pairs))
+		for _, f := range findings {
+			assert.True(t, f.Pass(), f.String())
+			assert.Equal(t, contrast.RatioOfArgbs(f.ForegroundArgb, f.BackgroundArgb), f.Contrast)
+		}
+	}
+
+	findings, err := NewLightScheme(0xff6750a4).Audit(MetricWCAG, 7)
+	require.NoError(t, err)
+	f := findings[0]
+	assert.Equal(t, Pair{"onPrimary", "primary"}, f.Pair)
+	assert.False(t, f.Pass())
+	assert.Equal(t, "onPrimary on primary: wcag 6.44 < 7.00", f.String())
+}
+
+func TestAuditAPCA(t *testing.T) {
+	light := NewLightScheme(0xff6750a4)
+	findings, err := light.Audit(MetricAPCA, MetricAPCA.DefaultMinimum())
+	require.NoError(t, err)
+	require.Len(t, findings, len(Pairs))
+	for _, f := range findings {
+		assert.Equal(t, MetricAPCA, f.Metric)
+		assert.GreaterOrEqual(t, f.Contrast, 0.0)
+	}
+	// onPrimary is light text on a dark primary, with a negative Lc.
+	assert.Equal(t, -contrast.LcOfArgbs(light.OnPrimary, light.Primary), findings[0].Contrast)
+	assert.Equal(t, contrast.LcOfArgbs(light.OnSurface, light.Surface), findings[9].Contrast)
+	assert.True(t, findings[9].Pass())
+}
+
+func TestAuditRejectsUnknownRoles(t *testing.T) {
+	defer func(pairs []Pair) { Pairs = pairs }(Pairs)
+	Pairs = []Pair{{"onPrimary", "primary"}, {"onPrimary", "primry"}}
+	_, err := NewLightScheme(0xff6750a4).Audit(MetricWCAG, 4.5)
+	assert.EqualError(t, err, `unknown scheme role "primry"`)
+
+	Pairs = []Pair{{"onPrimay", "primary"}}
+	_, err = NewLightScheme(0xff6750a4).Audit(MetricWCAG, 4.5)
+	assert.EqualError(t, err, `unknown scheme role "onPrimay"`)
+}
+
+func TestParseMetric(t *testing.T) {
+	for _, m := range []Metric{MetricWCAG, MetricAPCA} {
+		parsed, err := ParseMetric(m.String())
+		require.NoError(t, err)
+		assert.Equal(t, m, parsed)
+	}
+	_, err := ParseMetric("wcag3")
+	assert.Error(t, err)
+}
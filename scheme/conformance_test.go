@@ -0,0 +1,48 @@
+package scheme
+
+import (
+	"github.com/gio-eui/md3-colors/internal/golden"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+// The golden vectors in testdata are transcribed from the test suites of Material Color
+// Utilities, so that any divergence from the reference implementations fails go test.
+
+func TestConformanceSchemes(t *testing.T) {
+	var vectors struct {
+		Schemes []struct {
+			Name    string                 `json:"name"`
+			Seed    golden.Argb            `json:"seed"`
+			Variant string                 `json:"variant"`
+			Dark    bool                   `json:"dark"`
+			Roles   map[string]golden.Argb `json:"roles"`
+			// KnownDivergences maps roles on which the port knowingly differs from upstream to
+			// the reason why; they are reported as skipped rather than failed.
+			KnownDivergences map[string]string `json:"knownDivergences"`
+		} `json:"schemes"`
+	}
+	golden.Load(t, "schemes.json", &vectors)
+	require.NotEmpty(t, vectors.Schemes)
+
+	for _, s := range vectors.Schemes {
+		variant, err := ParseVariant(s.Variant)
+		require.NoError(t, err, s.Name)
+		scheme := NewScheme(int(s.Seed), variant, s.Dark)
+		for name, expected := range s.Roles {
+			argb, ok := scheme.Role(name)
+			require.True(t, ok, "%s: unknown role %s", s.Name, name)
+			if reason, ok := s.KnownDivergences[name]; ok {
+				t.Run(s.Name+"/"+name, func(t *testing.T) {
+					if argb == int(expected) {
+						t.Fatalf("known divergence now matches upstream 0x%08x; remove it", int(expected))
+					}
+					t.Skipf("known divergence: got 0x%08x, upstream 0x%08x: %s", argb, int(expected), reason)
+				})
+				continue
+			}
+			assert.Equal(t, int(expected), argb, "%s: %s", s.Name, name)
+		}
+	}
+}
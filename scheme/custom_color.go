@@ -0,0 +1,192 @@
+package scheme
+
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"fmt"
+	"github.com/gio-eui/md3-colors/blend"
+	"github.com/gio-eui/md3-colors/palettes"
+	"go/token"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// CustomColor is an application-defined color, such as "warning" or "brand-teal", that gets
+// its own group of roles next to the roles of a Scheme.
+type CustomColor struct {
+	// Name of the color; role names are derived from it, see CustomColorGroup.Roles. It must
+	// hold at least one word, the role names must not be roles of a Scheme, and the name in
+	// upper camel case must be a Go identifier: "warning" and "brand-teal" are valid names,
+	// "primary" and "1st" are not.
+	Name string
+	// Value is the ARGB color.
+	Value int
+	// Blend harmonizes the color towards the source color of the theme when true.
+	Blend bool
+}
+
+// ColorGroup holds the roles of a custom color in one of light and dark mode.
+type ColorGroup struct {
+	Color            int
+	OnColor          int
+	ColorContainer   int
+	OnColorContainer int
+}
+
+// CustomColorGroup holds the light and dark roles of a CustomColor.
+type CustomColorGroup struct {
+	Color CustomColor
+	// Value is the ARGB color the roles are derived from: the value of Color, harmonized
+	// towards the source color if Color.Blend is true.
+	Value int
+	// Palette is the TonalPalette of Value the roles are picked from.
+	Palette *palettes.TonalPalette
+	Light   ColorGroup
+	Dark    ColorGroup
+}
+
+// NewCustomColorGroup creates the CustomColorGroup of [color] for a theme of the ARGB
+// [source] color. It returns an error if the name of [color] is invalid, see
+// CustomColor.Validate.
+func NewCustomColorGroup(source int, color CustomColor) (*CustomColorGroup, error) {
+	if err := color.Validate(); err != nil {
+		return nil, err
+	}
+	value := color.Value
+	if color.Blend {
+		value = blend.Harmonize(value, source)
+	}
+	return NewCustomColorGroupFromPalette(color, value, palettes.NewCorePaletteFromInt(value).A1)
+}
+
+// NewCustomColorGroupFromPalette creates the CustomColorGroup of [color] whose roles are
+// picked from [palette], the TonalPalette of the ARGB [value]. It returns an error if the
+// name of [color] is invalid, see CustomColor.Validate.
+func NewCustomColorGroupFromPalette(color CustomColor, value int, palette *palettes.TonalPalette) (*CustomColorGroup, error) {
+	if err := color.Validate(); err != nil {
+		return nil, err
+	}
+	return &CustomColorGroup{
+		Color:   color,
+		Value:   value,
+		Palette: palette,
+		Light: ColorGroup{
+			Color:            palette.Tone(40),
+			OnColor:          palette.Tone(100),
+			ColorContainer:   palette.Tone(90),
+			OnColorContainer: palette.Tone(10),
+		},
+		Dark: ColorGroup{
+			Color:            palette.Tone(80),
+			OnColor:          palette.Tone(20),
+			ColorContainer:   palette.Tone(30),
+			OnColorContainer: palette.Tone(90),
+		},
+	}, nil
+}
+
+// Validate returns an error if the name of the CustomColor is empty, collides with a role of
+// a Scheme, or is not a Go identifier in upper camel case.
+func (c CustomColor) Validate() error {
+	name := camelCase(c.Name)
+	if name == "" {
+		return fmt.Errorf("empty custom color name %q", c.Name)
+	}
+	if !token.IsIdentifier(upperFirst(name)) {
+		return fmt.Errorf("invalid custom color name %q", c.Name)
+	}
+	var s Scheme
+	for _, role := range customColorRoleNames(name) {
+		if _, ok := s.Role(role); ok {
+			return fmt.Errorf("custom color name %q collides with scheme role %q", c.Name, role)
+		}
+	}
+	return nil
+}
+
+// Group returns the dark roles of the CustomColorGroup if [isDark] is true, and the light
+// roles otherwise.
+func (g *CustomColorGroup) Group(isDark bool) ColorGroup {
+	if isDark {
+		return g.Dark
+	}
+	return g.Light
+}
+
+// Name returns the name of the custom color in lower camel case, such as "brandTeal" for a
+// color named "brand-teal".
+func (g *CustomColorGroup) Name() string {
+	return camelCase(g.Color.Name)
+}
+
+// Roles returns the light or dark roles of the CustomColorGroup, named after Name: a color
+// named "brand-teal" has the roles "brandTeal", "onBrandTeal", "brandTealContainer" and
+// "onBrandTealContainer".
+func (g *CustomColorGroup) Roles(isDark bool) []Role {
+	names := customColorRoleNames(g.Name())
+	group := g.Group(isDark)
+	return []Role{
+		{names[0], group.Color},
+		{names[1], group.OnColor},
+		{names[2], group.ColorContainer},
+		{names[3], group.OnColorContainer},
+	}
+}
+
+// customColorRoleNames returns the names of the roles of a custom color whose name in lower
+// camel case is [name], in the order of CustomColorGroup.Roles.
+func customColorRoleNames(name string) [4]string {
+	return [4]string{
+		name,
+		"on" + upperFirst(name),
+		name + "Container",
+		"on" + upperFirst(name) + "Container",
+	}
+}
+
+// camelCase converts a name made of words separated by spaces, dashes or underscores to lower
+// camel case.
+func camelCase(name string) string {
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return r == '-' || r == '_' || unicode.IsSpace(r)
+	})
+	for i, word := range words {
+		if i == 0 {
+			words[i] = lowerFirst(word)
+		} else {
+			words[i] = upperFirst(word)
+		}
+	}
+	return strings.Join(words, "")
+}
+
+// upperFirst returns [s] with its first letter in upper case.
+func upperFirst(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	if size == 0 {
+		return s
+	}
+	return string(unicode.ToUpper(r)) + s[size:]
+}
+
+// lowerFirst returns [s] with its first letter in lower case.
+func lowerFirst(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	if size == 0 {
+		return s
+	}
+	return string(unicode.ToLower(r)) + s[size:]
+}
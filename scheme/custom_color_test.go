@@ -0,0 +1,72 @@
+package scheme
+
+import (
+	"github.com/gio-eui/md3-colors/blend"
+	"github.com/gio-eui/md3-colors/palettes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestNewCustomColorGroup(t *testing.T) {
+	warning := CustomColor{Name: "warning", Value: 0xfffbbc05}
+	group, err := NewCustomColorGroup(0xff6750a4, warning)
+	require.NoError(t, err)
+
+	assert.Equal(t, warning, group.Color)
+	assert.Equal(t, warning.Value, group.Value)
+	tones := palettes.NewCorePaletteFromInt(warning.Value).A1
+	assert.Equal(t, ColorGroup{
+		Color:            tones.Tone(40),
+		OnColor:          tones.Tone(100),
+		ColorContainer:   tones.Tone(90),
+		OnColorContainer: tones.Tone(10),
+	}, group.Light)
+	assert.Equal(t, ColorGroup{
+		Color:            tones.Tone(80),
+		OnColor:          tones.Tone(20),
+		ColorContainer:   tones.Tone(30),
+		OnColorContainer: tones.Tone(90),
+	}, group.Dark)
+	assert.Equal(t, group.Dark, group.Group(true))
+	assert.Equal(t, group.Light, group.Group(false))
+}
+
+func TestNewCustomColorGroupHarmonizes(t *testing.T) {
+	source := 0xff0000ff
+	teal := CustomColor{Name: "brand-teal", Value: 0xff00796b, Blend: true}
+	group, err := NewCustomColorGroup(source, teal)
+	require.NoError(t, err)
+
+	assert.Equal(t, blend.Harmonize(teal.Value, source), group.Value)
+	assert.NotEqual(t, teal.Value, group.Value)
+	assert.Equal(t, group.Palette.Tone(40), group.Light.Color)
+}
+
+func TestCustomColorGroupRoles(t *testing.T) {
+	group, err := NewCustomColorGroup(0xff6750a4, CustomColor{Name: "brand-teal", Value: 0xff00796b})
+	require.NoError(t, err)
+	assert.Equal(t, []Role{
+		{"brandTeal", group.Dark.Color},
+		{"onBrandTeal", group.Dark.OnColor},
+		{"brandTealContainer", group.Dark.ColorContainer},
+		{"onBrandTealContainer", group.Dark.OnColorContainer},
+	}, group.Roles(true))
+
+	assert.Equal(t, "warning", camelCase("Warning"))
+	assert.Equal(t, "statusOk", camelCase("status_ok"))
+	assert.Equal(t, "brandTeal", camelCase("brand teal"))
+	assert.Equal(t, "ärgerÜber", camelCase("Ärger über"))
+	assert.Equal(t, "", camelCase("--"))
+}
+
+func TestNewCustomColorGroupRejectsInvalidNames(t *testing.T) {
+	for _, name := range []string{"", "--", " _ ", "1st", "brand.teal", "primary", "Surface Container", "on-primary"} {
+		_, err := NewCustomColorGroup(0xff6750a4, CustomColor{Name: name, Value: 0xff00796b})
+		assert.Error(t, err, name)
+	}
+	for _, name := range []string{"warning", "go", "ärger", "brand-teal"} {
+		_, err := NewCustomColorGroup(0xff6750a4, CustomColor{Name: name, Value: 0xff00796b})
+		assert.NoError(t, err, name)
+	}
+}
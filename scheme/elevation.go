@@ -0,0 +1,67 @@
+package scheme
+
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	colorUtils "github.com/gio-eui/md3-colors/utils/color"
+	mathUtils "github.com/gio-eui/md3-colors/utils/math"
+	"math"
+)
+
+// elevationLevels holds the elevation in dp and the opacity of the surface tint of every
+// elevation level, from level 0 to level 5.
+var elevationLevels = [6]struct {
+	dp      float64
+	opacity float64
+}{
+	{0, 0},
+	{1, 0.05},
+	{3, 0.08},
+	{6, 0.11},
+	{8, 0.12},
+	{12, 0.14},
+}
+
+// ElevationDp returns the elevation in dp of an elevation [level], between 0 and 5.
+func ElevationDp(level int) float64 {
+	return elevationLevels[mathUtils.ClampInt(0, len(elevationLevels)-1, level)].dp
+}
+
+// TintOpacityAtLevel returns the opacity of the surface tint of an elevation [level], between
+// 0 and 5.
+func TintOpacityAtLevel(level int) float64 {
+	return elevationLevels[mathUtils.ClampInt(0, len(elevationLevels)-1, level)].opacity
+}
+
+// TintOpacityAtElevation returns the opacity of the surface tint at an elevation of [dp], as
+// computed by Jetpack Compose. It approximates TintOpacityAtLevel at the dp of every level.
+func TintOpacityAtElevation(dp float64) float64 {
+	if dp <= 0 {
+		return 0
+	}
+	return (4.5*math.Log(dp+1) + 2) / 100
+}
+
+// SurfaceAtLevel returns the opaque surface color of the Scheme at an elevation [level],
+// between 0 and 5: SurfaceTint composited over Surface.
+func (s *Scheme) SurfaceAtLevel(level int) int {
+	return colorUtils.Overlay(s.Surface, s.SurfaceTint, TintOpacityAtLevel(level))
+}
+
+// SurfaceAtElevation returns the opaque surface color of the Scheme at an elevation of [dp]:
+// SurfaceTint composited over Surface.
+func (s *Scheme) SurfaceAtElevation(dp float64) int {
+	return colorUtils.Overlay(s.Surface, s.SurfaceTint, TintOpacityAtElevation(dp))
+}
@@ -0,0 +1,33 @@
+package scheme
+
+import (
+	colorUtils "github.com/gio-eui/md3-colors/utils/color"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTintOpacity(t *testing.T) {
+	assert.Equal(t, 0.0, TintOpacityAtLevel(0))
+	assert.Equal(t, 0.14, TintOpacityAtLevel(5))
+	assert.Equal(t, 0.14, TintOpacityAtLevel(9))
+	assert.Equal(t, 0.0, TintOpacityAtLevel(-1))
+	assert.Equal(t, 12.0, ElevationDp(5))
+
+	assert.Equal(t, 0.0, TintOpacityAtElevation(0))
+	assert.Equal(t, 0.0, TintOpacityAtElevation(-3))
+	for level := 1; level <= 5; level++ {
+		assert.InDelta(t, TintOpacityAtLevel(level), TintOpacityAtElevation(ElevationDp(level)), 0.01)
+	}
+}
+
+func TestSurfaceAtLevel(t *testing.T) {
+	for _, s := range []*Scheme{NewLightScheme(0xff6750a4), NewDarkScheme(0xff6750a4)} {
+		assert.Equal(t, s.Surface, s.SurfaceAtLevel(0))
+		assert.Equal(t, s.Surface, s.SurfaceAtElevation(0))
+		assert.Equal(t, colorUtils.Overlay(s.Surface, s.Primary, 0.14), s.SurfaceAtLevel(5))
+		for level := 0; level <= 5; level++ {
+			assert.True(t, colorUtils.IsOpaque(s.SurfaceAtLevel(level)))
+		}
+	}
+	assert.Equal(t, 0xfff1ebf6, NewLightScheme(0xff6750a4).SurfaceAtLevel(2))
+}
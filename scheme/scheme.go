@@ -0,0 +1,333 @@
+package scheme
+
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"fmt"
+	"github.com/gio-eui/md3-colors/palettes"
+)
+
+// Variant selects how the palettes of a Scheme are derived from its seed color.
+type Variant int
+
+const (
+	// VariantDefault derives palettes with fixed chroma, as NewCorePaletteFromInt.
+	VariantDefault Variant = iota
+	// VariantContent derives palettes that stay close to the seed color, as
+	// NewContentCorePaletteFromInt. It suits schemes built from content such as images.
+	VariantContent
+)
+
+// String returns the name of the variant.
+func (v Variant) String() string {
+	switch v {
+	case VariantContent:
+		return "content"
+	default:
+		return "default"
+	}
+}
+
+// ParseVariant returns the Variant named [name], as returned by Variant.String.
+func ParseVariant(name string) (Variant, error) {
+	for _, v := range []Variant{VariantDefault, VariantContent} {
+		if v.String() == name {
+			return v, nil
+		}
+	}
+	return VariantDefault, fmt.Errorf("unknown scheme variant %q", name)
+}
+
+// Scheme represents a Material color scheme, a mapping of color roles to ARGB colors.
+type Scheme struct {
+	Primary            int
+	OnPrimary          int
+	PrimaryContainer   int
+	OnPrimaryContainer int
+
+	Secondary            int
+	OnSecondary          int
+	SecondaryContainer   int
+	OnSecondaryContainer int
+
+	Tertiary            int
+	OnTertiary          int
+	TertiaryContainer   int
+	OnTertiaryContainer int
+
+	Error            int
+	OnError          int
+	ErrorContainer   int
+	OnErrorContainer int
+
+	Background       int
+	OnBackground     int
+	Surface          int
+	OnSurface        int
+	SurfaceVariant   int
+	OnSurfaceVariant int
+	Outline          int
+	OutlineVariant   int
+	Shadow           int
+	Scrim            int
+	InverseSurface   int
+	InverseOnSurface int
+	InversePrimary   int
+
+	// The roles below were added to Material 3 after the 2021 role set above. Surface and
+	// Background follow the same guidance, with N1 98 in light schemes and N1 6 in dark
+	// schemes rather than the 2021 N1 99 and N1 10: Surface equals SurfaceBright in light
+	// schemes and SurfaceDim in dark schemes.
+
+	SurfaceTint             int
+	SurfaceDim              int
+	SurfaceBright           int
+	SurfaceContainerLowest  int
+	SurfaceContainerLow     int
+	SurfaceContainer        int
+	SurfaceContainerHigh    int
+	SurfaceContainerHighest int
+
+	// Fixed roles keep the same color in light and dark schemes.
+
+	PrimaryFixed            int
+	PrimaryFixedDim         int
+	OnPrimaryFixed          int
+	OnPrimaryFixedVariant   int
+	SecondaryFixed          int
+	SecondaryFixedDim       int
+	OnSecondaryFixed        int
+	OnSecondaryFixedVariant int
+	TertiaryFixed           int
+	TertiaryFixedDim        int
+	OnTertiaryFixed         int
+	OnTertiaryFixedVariant  int
+}
+
+// Role is a color role of a Scheme with its ARGB color.
+type Role struct {
+	// Name is the name of the role in lower camel case, such as "onPrimaryContainer".
+	Name string
+	Argb int
+}
+
+// NewScheme creates the light or dark Scheme of [variant] from an ARGB seed color.
+func NewScheme(argb int, variant Variant, isDark bool) *Scheme {
+	core := palettes.NewCorePaletteFromInt(argb)
+	if variant == VariantContent {
+		core = palettes.NewContentCorePaletteFromInt(argb)
+	}
+	if isDark {
+		return NewDarkSchemeFromCorePalette(core)
+	}
+	return NewLightSchemeFromCorePalette(core)
+}
+
+// NewLightScheme creates a light Scheme from an ARGB seed color.
+func NewLightScheme(argb int) *Scheme {
+	return NewScheme(argb, VariantDefault, false)
+}
+
+// NewDarkScheme creates a dark Scheme from an ARGB seed color.
+func NewDarkScheme(argb int) *Scheme {
+	return NewScheme(argb, VariantDefault, true)
+}
+
+// NewLightContentScheme creates a light Scheme from an ARGB color extracted from content.
+func NewLightContentScheme(argb int) *Scheme {
+	return NewScheme(argb, VariantContent, false)
+}
+
+// NewDarkContentScheme creates a dark Scheme from an ARGB color extracted from content.
+func NewDarkContentScheme(argb int) *Scheme {
+	return NewScheme(argb, VariantContent, true)
+}
+
+// NewLightSchemeFromCorePalette creates a light Scheme from the palettes of [core].
+func NewLightSchemeFromCorePalette(core *palettes.CorePalette) *Scheme {
+	return &Scheme{
+		Primary:            core.A1.Tone(40),
+		OnPrimary:          core.A1.Tone(100),
+		PrimaryContainer:   core.A1.Tone(90),
+		OnPrimaryContainer: core.A1.Tone(10),
+
+		Secondary:            core.A2.Tone(40),
+		OnSecondary:          core.A2.Tone(100),
+		SecondaryContainer:   core.A2.Tone(90),
+		OnSecondaryContainer: core.A2.Tone(10),
+
+		Tertiary:            core.A3.Tone(40),
+		OnTertiary:          core.A3.Tone(100),
+		TertiaryContainer:   core.A3.Tone(90),
+		OnTertiaryContainer: core.A3.Tone(10),
+
+		Error:            core.Error.Tone(40),
+		OnError:          core.Error.Tone(100),
+		ErrorContainer:   core.Error.Tone(90),
+		OnErrorContainer: core.Error.Tone(10),
+
+		Background:       core.N1.Tone(98),
+		OnBackground:     core.N1.Tone(10),
+		Surface:          core.N1.Tone(98),
+		OnSurface:        core.N1.Tone(10),
+		SurfaceVariant:   core.N2.Tone(90),
+		OnSurfaceVariant: core.N2.Tone(30),
+		Outline:          core.N2.Tone(50),
+		OutlineVariant:   core.N2.Tone(80),
+		Shadow:           core.N1.Tone(0),
+		Scrim:            core.N1.Tone(0),
+		InverseSurface:   core.N1.Tone(20),
+		InverseOnSurface: core.N1.Tone(95),
+		InversePrimary:   core.A1.Tone(80),
+
+		SurfaceTint:             core.A1.Tone(40),
+		SurfaceDim:              core.N1.Tone(87),
+		SurfaceBright:           core.N1.Tone(98),
+		SurfaceContainerLowest:  core.N1.Tone(100),
+		SurfaceContainerLow:     core.N1.Tone(96),
+		SurfaceContainer:        core.N1.Tone(94),
+		SurfaceContainerHigh:    core.N1.Tone(92),
+		SurfaceContainerHighest: core.N1.Tone(90),
+
+		PrimaryFixed:            core.A1.Tone(90),
+		PrimaryFixedDim:         core.A1.Tone(80),
+		OnPrimaryFixed:          core.A1.Tone(10),
+		OnPrimaryFixedVariant:   core.A1.Tone(30),
+		SecondaryFixed:          core.A2.Tone(90),
+		SecondaryFixedDim:       core.A2.Tone(80),
+		OnSecondaryFixed:        core.A2.Tone(10),
+		OnSecondaryFixedVariant: core.A2.Tone(30),
+		TertiaryFixed:           core.A3.Tone(90),
+		TertiaryFixedDim:        core.A3.Tone(80),
+		OnTertiaryFixed:         core.A3.Tone(10),
+		OnTertiaryFixedVariant:  core.A3.Tone(30),
+	}
+}
+
+// NewDarkSchemeFromCorePalette creates a dark Scheme from the palettes of [core].
+func NewDarkSchemeFromCorePalette(core *palettes.CorePalette) *Scheme {
+	return &Scheme{
+		Primary:            core.A1.Tone(80),
+		OnPrimary:          core.A1.Tone(20),
+		PrimaryContainer:   core.A1.Tone(30),
+		OnPrimaryContainer: core.A1.Tone(90),
+
+		Secondary:            core.A2.Tone(80),
+		OnSecondary:          core.A2.Tone(20),
+		SecondaryContainer:   core.A2.Tone(30),
+		OnSecondaryContainer: core.A2.Tone(90),
+
+		Tertiary:            core.A3.Tone(80),
+		OnTertiary:          core.A3.Tone(20),
+		TertiaryContainer:   core.A3.Tone(30),
+		OnTertiaryContainer: core.A3.Tone(90),
+
+		Error:            core.Error.Tone(80),
+		OnError:          core.Error.Tone(20),
+		ErrorContainer:   core.Error.Tone(30),
+		OnErrorContainer: core.Error.Tone(80),
+
+		Background:       core.N1.Tone(6),
+		OnBackground:     core.N1.Tone(90),
+		Surface:          core.N1.Tone(6),
+		OnSurface:        core.N1.Tone(90),
+		SurfaceVariant:   core.N2.Tone(30),
+		OnSurfaceVariant: core.N2.Tone(80),
+		Outline:          core.N2.Tone(60),
+		OutlineVariant:   core.N2.Tone(30),
+		Shadow:           core.N1.Tone(0),
+		Scrim:            core.N1.Tone(0),
+		InverseSurface:   core.N1.Tone(90),
+		InverseOnSurface: core.N1.Tone(20),
+		InversePrimary:   core.A1.Tone(40),
+
+		SurfaceTint:             core.A1.Tone(80),
+		SurfaceDim:              core.N1.Tone(6),
+		SurfaceBright:           core.N1.Tone(24),
+		SurfaceContainerLowest:  core.N1.Tone(4),
+		SurfaceContainerLow:     core.N1.Tone(10),
+		SurfaceContainer:        core.N1.Tone(12),
+		SurfaceContainerHigh:    core.N1.Tone(17),
+		SurfaceContainerHighest: core.N1.Tone(22),
+
+		PrimaryFixed:            core.A1.Tone(90),
+		PrimaryFixedDim:         core.A1.Tone(80),
+		OnPrimaryFixed:          core.A1.Tone(10),
+		OnPrimaryFixedVariant:   core.A1.Tone(30),
+		SecondaryFixed:          core.A2.Tone(90),
+		SecondaryFixedDim:       core.A2.Tone(80),
+		OnSecondaryFixed:        core.A2.Tone(10),
+		OnSecondaryFixedVariant: core.A2.Tone(30),
+		TertiaryFixed:           core.A3.Tone(90),
+		TertiaryFixedDim:        core.A3.Tone(80),
+		OnTertiaryFixed:         core.A3.Tone(10),
+		OnTertiaryFixedVariant:  core.A3.Tone(30),
+	}
+}
+
+// Roles returns every color role of the Scheme, in declaration order.
+func (s *Scheme) Roles() []Role {
+	return []Role{
+		{"primary", s.Primary},
+		{"onPrimary", s.OnPrimary},
+		{"primaryContainer", s.PrimaryContainer},
+		{"onPrimaryContainer", s.OnPrimaryContainer},
+		{"secondary", s.Secondary},
+		{"onSecondary", s.OnSecondary},
+		{"secondaryContainer", s.SecondaryContainer},
+		{"onSecondaryContainer", s.OnSecondaryContainer},
+		{"tertiary", s.Tertiary},
+		{"onTertiary", s.OnTertiary},
+		{"tertiaryContainer", s.TertiaryContainer},
+		{"onTertiaryContainer", s.OnTertiaryContainer},
+		{"error", s.Error},
+		{"onError", s.OnError},
+		{"errorContainer", s.ErrorContainer},
+		{"onErrorContainer", s.OnErrorContainer},
+		{"background", s.Background},
+		{"onBackground", s.OnBackground},
+		{"surface", s.Surface},
+		{"onSurface", s.OnSurface},
+		{"surfaceVariant", s.SurfaceVariant},
+		{"onSurfaceVariant", s.OnSurfaceVariant},
+		{"outline", s.Outline},
+		{"outlineVariant", s.OutlineVariant},
+		{"shadow", s.Shadow},
+		{"scrim", s.Scrim},
+		{"inverseSurface", s.InverseSurface},
+		{"inverseOnSurface", s.InverseOnSurface},
+		{"inversePrimary", s.InversePrimary},
+		{"surfaceTint", s.SurfaceTint},
+		{"surfaceDim", s.SurfaceDim},
+		{"surfaceBright", s.SurfaceBright},
+		{"surfaceContainerLowest", s.SurfaceContainerLowest},
+		{"surfaceContainerLow", s.SurfaceContainerLow},
+		{"surfaceContainer", s.SurfaceContainer},
+		{"surfaceContainerHigh", s.SurfaceContainerHigh},
+		{"surfaceContainerHighest", s.SurfaceContainerHighest},
+		{"primaryFixed", s.PrimaryFixed},
+		{"primaryFixedDim", s.PrimaryFixedDim},
+		{"onPrimaryFixed", s.OnPrimaryFixed},
+		{"onPrimaryFixedVariant", s.OnPrimaryFixedVariant},
+		{"secondaryFixed", s.SecondaryFixed},
+		{"secondaryFixedDim", s.SecondaryFixedDim},
+		{"onSecondaryFixed", s.OnSecondaryFixed},
+		{"onSecondaryFixedVariant", s.OnSecondaryFixedVariant},
+		{"tertiaryFixed", s.TertiaryFixed},
+		{"tertiaryFixedDim", s.TertiaryFixedDim},
+		{"onTertiaryFixed", s.OnTertiaryFixed},
+		{"onTertiaryFixedVariant", s.OnTertiaryFixedVariant},
+	}
+}
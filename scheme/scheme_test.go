@@ -0,0 +1,153 @@
+package scheme
+
+import (
+	"github.com/gio-eui/md3-colors/palettes"
+	colorUtils "github.com/gio-eui/md3-colors/utils/color"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestLightScheme(t *testing.T) {
+	scheme := NewLightScheme(0xff0000ff)
+	assert.Equal(t, 0xff343dff, scheme.Primary)
+	assert.Equal(t, 0xffffffff, scheme.OnPrimary)
+	assert.Equal(t, 0xffe0e0ff, scheme.PrimaryContainer)
+	assert.Equal(t, 0xff00006e, scheme.OnPrimaryContainer)
+}
+
+func TestDarkScheme(t *testing.T) {
+	scheme := NewDarkScheme(0xff0000ff)
+	assert.Equal(t, 0xffbec2ff, scheme.Primary)
+	assert.Equal(t, 0xff0001ac, scheme.OnPrimary)
+	assert.Equal(t, 0xff0000ef, scheme.PrimaryContainer)
+	assert.Equal(t, 0xffe0e0ff, scheme.OnPrimaryContainer)
+	// Upstream picks onErrorContainer from error tone 80 in dark schemes, not tone 90.
+	assert.Equal(t, palettes.NewCorePaletteFromInt(0xff0000ff).Error.Tone(80), scheme.OnErrorContainer)
+}
+
+func TestLightThirdPartyScheme(t *testing.T) {
+	scheme := NewLightScheme(0xff6750a4)
+	assert.Equal(t, 0xff6750a4, scheme.Primary)
+	assert.Equal(t, 0xff625b71, scheme.Secondary)
+	assert.Equal(t, 0xff7e5260, scheme.Tertiary)
+	assert.Equal(t, 0xfffdf8fd, scheme.Surface)
+	assert.Equal(t, 0xff1c1b1e, scheme.OnSurface)
+}
+
+func TestDarkThirdPartyScheme(t *testing.T) {
+	scheme := NewDarkScheme(0xff6750a4)
+	assert.Equal(t, 0xffcfbcff, scheme.Primary)
+	assert.Equal(t, 0xffcbc2db, scheme.Secondary)
+	assert.Equal(t, 0xffefb8c8, scheme.Tertiary)
+	assert.Equal(t, 0xff141316, scheme.Surface)
+	assert.Equal(t, 0xffe6e1e6, scheme.OnSurface)
+}
+
+func TestContentSchemeKeepsSeedChroma(t *testing.T) {
+	seed := 0xff00796b
+	content := NewLightContentScheme(seed)
+	assert.NotEqual(t, NewLightScheme(seed).Secondary, content.Secondary)
+	assert.Equal(t, NewScheme(seed, VariantContent, true), NewDarkContentScheme(seed))
+}
+
+func TestSchemeRoles(t *testing.T) {
+	scheme := NewLightScheme(0xff6750a4)
+	roles := scheme.Roles()
+	require.Len(t, roles, 49)
+	assert.Equal(t, Role{"primary", scheme.Primary}, roles[0])
+	assert.Equal(t, Role{"inversePrimary", scheme.InversePrimary}, roles[28])
+	assert.Equal(t, Role{"onTertiaryFixedVariant", scheme.OnTertiaryFixedVariant}, roles[len(roles)-1])
+
+	names := make(map[string]bool)
+	for _, role := range roles {
+		assert.False(t, names[role.Name], role.Name)
+		names[role.Name] = true
+	}
+}
+
+func TestParseVariant(t *testing.T) {
+	for _, variant := range []Variant{VariantDefault, VariantContent} {
+		parsed, err := ParseVariant(variant.String())
+		require.NoError(t, err)
+		assert.Equal(t, variant, parsed)
+	}
+	_, err := ParseVariant("vibrant")
+	assert.Error(t, err)
+}
+
+func TestSurfaceContainerRoles(t *testing.T) {
+	core := palettes.NewCorePaletteFromInt(0xff6750a4)
+	light := NewLightSchemeFromCorePalette(core)
+	assert.Equal(t, light.Primary, light.SurfaceTint)
+	assert.Equal(t, core.N1.Tone(87), light.SurfaceDim)
+	assert.Equal(t, core.N1.Tone(98), light.SurfaceBright)
+	assert.Equal(t, 0xffffffff, light.SurfaceContainerLowest)
+	assert.Equal(t, core.N1.Tone(96), light.SurfaceContainerLow)
+	assert.Equal(t, core.N1.Tone(94), light.SurfaceContainer)
+	assert.Equal(t, core.N1.Tone(92), light.SurfaceContainerHigh)
+	assert.Equal(t, core.N1.Tone(90), light.SurfaceContainerHighest)
+
+	dark := NewDarkSchemeFromCorePalette(core)
+	assert.Equal(t, dark.Primary, dark.SurfaceTint)
+	assert.Equal(t, core.N1.Tone(6), dark.SurfaceDim)
+	assert.Equal(t, core.N1.Tone(24), dark.SurfaceBright)
+	assert.Equal(t, core.N1.Tone(4), dark.SurfaceContainerLowest)
+	assert.Equal(t, core.N1.Tone(10), dark.SurfaceContainerLow)
+	assert.Equal(t, core.N1.Tone(12), dark.SurfaceContainer)
+	assert.Equal(t, core.N1.Tone(17), dark.SurfaceContainerHigh)
+	assert.Equal(t, core.N1.Tone(22), dark.SurfaceContainerHighest)
+}
+
+func TestSurfaceRolesOrdering(t *testing.T) {
+	for _, seed := range []int{0xff6750a4, 0xff0000ff, 0xfffa2bec, 0xff9e9e9e} {
+		light := NewLightScheme(seed)
+		assertDarker(t, light.SurfaceDim, light.Surface)
+		assert.Equal(t, light.SurfaceBright, light.Surface)
+		assertDarker(t, light.Surface, light.SurfaceContainerLowest)
+		assertDarker(t, light.SurfaceContainerLow, light.Surface)
+		assertDarker(t, light.SurfaceContainerHighest, light.SurfaceContainerHigh)
+		assertDarker(t, light.SurfaceContainerHigh, light.SurfaceContainer)
+		assertDarker(t, light.SurfaceContainer, light.SurfaceContainerLow)
+		assertDarker(t, light.SurfaceContainerLow, light.SurfaceContainerLowest)
+		assert.Equal(t, light.Surface, light.Background)
+
+		dark := NewDarkScheme(seed)
+		assert.Equal(t, dark.SurfaceDim, dark.Surface)
+		assertDarker(t, dark.Surface, dark.SurfaceBright)
+		assertDarker(t, dark.SurfaceContainerLowest, dark.Surface)
+		assertDarker(t, dark.Surface, dark.SurfaceContainerLow)
+		assertDarker(t, dark.SurfaceContainerLowest, dark.SurfaceContainerLow)
+		assertDarker(t, dark.SurfaceContainerLow, dark.SurfaceContainer)
+		assertDarker(t, dark.SurfaceContainer, dark.SurfaceContainerHigh)
+		assertDarker(t, dark.SurfaceContainerHigh, dark.SurfaceContainerHighest)
+		assert.Equal(t, dark.Surface, dark.Background)
+	}
+}
+
+// assertDarker asserts that the ARGB color [darker] has a lower L* than [lighter].
+func assertDarker(t *testing.T, darker, lighter int) {
+	t.Helper()
+	assert.Less(t, colorUtils.LstarFromArgb(darker), colorUtils.LstarFromArgb(lighter),
+		"0x%08x is not darker than 0x%08x", darker, lighter)
+}
+
+func TestFixedRolesMatchInLightAndDark(t *testing.T) {
+	light := NewLightScheme(0xff6750a4)
+	dark := NewDarkScheme(0xff6750a4)
+	assert.Equal(t, 0xffe9ddff, light.PrimaryFixed)
+	assert.Equal(t, 0xffcfbcff, light.PrimaryFixedDim)
+	assert.Equal(t, 0xff22005d, light.OnPrimaryFixed)
+	assert.Equal(t, 0xff4f378a, light.OnPrimaryFixedVariant)
+	assert.Equal(t, light.PrimaryContainer, light.PrimaryFixed)
+	assert.Equal(t, dark.Primary, light.PrimaryFixedDim)
+
+	fixed := func(s *Scheme) []int {
+		return []int{
+			s.PrimaryFixed, s.PrimaryFixedDim, s.OnPrimaryFixed, s.OnPrimaryFixedVariant,
+			s.SecondaryFixed, s.SecondaryFixedDim, s.OnSecondaryFixed, s.OnSecondaryFixedVariant,
+			s.TertiaryFixed, s.TertiaryFixedDim, s.OnTertiaryFixed, s.OnTertiaryFixedVariant,
+		}
+	}
+	assert.Equal(t, fixed(light), fixed(dark))
+}
@@ -0,0 +1,115 @@
+package scheme
+
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"fmt"
+	colorUtils "github.com/gio-eui/md3-colors/utils/color"
+)
+
+// State is an interaction state of a component, shown by a state layer of its content color
+// over its container color.
+type State int
+
+const (
+	// StateHover is the state of a component under the pointer.
+	StateHover State = iota
+	// StateFocus is the state of a component that has the keyboard focus.
+	StateFocus
+	// StatePressed is the state of a component being pressed or tapped.
+	StatePressed
+	// StateDragged is the state of a component being dragged.
+	StateDragged
+)
+
+// Opacities of the disabled state, applied to the content color of a component.
+const (
+	DisabledContainerOpacity = 0.12
+	DisabledContentOpacity   = 0.38
+)
+
+// String returns the name of the state.
+func (st State) String() string {
+	switch st {
+	case StateHover:
+		return "hover"
+	case StateFocus:
+		return "focus"
+	case StatePressed:
+		return "pressed"
+	case StateDragged:
+		return "dragged"
+	default:
+		return fmt.Sprintf("State(%d)", int(st))
+	}
+}
+
+// Opacity returns the opacity of the state layer of the state, or 0.0 for an unknown state.
+func (st State) Opacity() float64 {
+	switch st {
+	case StateHover:
+		return 0.08
+	case StateFocus, StatePressed:
+		return 0.10
+	case StateDragged:
+		return 0.16
+	default:
+		return 0.0
+	}
+}
+
+// StateLayer returns the color of a [container] color in [state], with the state layer of its
+// [content] color composited over it. The result is opaque only if [container] is; a
+// translucent [container] gives a translucent result, to be composited over whatever lies
+// beneath the component.
+func StateLayer(container, content int, state State) int {
+	return colorUtils.Overlay(container, content, state.Opacity())
+}
+
+// Disabled returns the colors of a disabled component drawn over [background], opaque if
+// [background] is: its container, [content] at DisabledContainerOpacity over [background],
+// and its content, [content] at DisabledContentOpacity over the container.
+//
+// MD3 components use the onSurface role as [content], whatever their enabled colors.
+func Disabled(background, content int) (container, onContainer int) {
+	container = colorUtils.Overlay(background, content, DisabledContainerOpacity)
+	onContainer = colorUtils.Overlay(container, content, DisabledContentOpacity)
+	return container, onContainer
+}
+
+// Role returns the ARGB color of the role named [name], as listed by Roles, and whether the
+// Scheme has such a role.
+func (s *Scheme) Role(name string) (int, bool) {
+	for _, role := range s.Roles() {
+		if role.Name == name {
+			return role.Argb, true
+		}
+	}
+	return 0, false
+}
+
+// StateLayer returns the opaque color of the [container] role in [state], with the state layer
+// of the [content] role composited over it, such as "primary" and "onPrimary".
+func (s *Scheme) StateLayer(container, content string, state State) (int, error) {
+	containerArgb, ok := s.Role(container)
+	if !ok {
+		return 0, fmt.Errorf("unknown scheme role %q", container)
+	}
+	contentArgb, ok := s.Role(content)
+	if !ok {
+		return 0, fmt.Errorf("unknown scheme role %q", content)
+	}
+	return StateLayer(containerArgb, contentArgb, state), nil
+}
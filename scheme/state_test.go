@@ -0,0 +1,59 @@
+package scheme
+
+import (
+	colorUtils "github.com/gio-eui/md3-colors/utils/color"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestStateOpacity(t *testing.T) {
+	assert.Equal(t, 0.08, StateHover.Opacity())
+	assert.Equal(t, 0.10, StateFocus.Opacity())
+	assert.Equal(t, 0.10, StatePressed.Opacity())
+	assert.Equal(t, 0.16, StateDragged.Opacity())
+	assert.Equal(t, 0.0, State(9).Opacity())
+	assert.Equal(t, "pressed", StatePressed.String())
+	assert.Equal(t, "State(9)", State(9).String())
+}
+
+func TestStateLayer(t *testing.T) {
+	assert.Equal(t, 0xff141414, StateLayer(0xff000000, 0xffffffff, StateHover))
+	assert.Equal(t, 0xff292929, StateLayer(0xff000000, 0xffffffff, StateDragged))
+	// A translucent container gives a translucent result: 8% white over 50% black.
+	translucent := StateLayer(0x80000000, 0xffffffff, StateHover)
+	assert.Equal(t, 0x8a262626, translucent)
+	assert.Less(t, colorUtils.AlphaFromArgb(translucent), 0xff)
+
+	s := NewLightScheme(0xff6750a4)
+	for _, state := range []State{StateHover, StateFocus, StatePressed, StateDragged} {
+		argb, err := s.StateLayer("primary", "onPrimary", state)
+		require.NoError(t, err)
+		assert.Equal(t, StateLayer(s.Primary, s.OnPrimary, state), argb)
+		assert.True(t, colorUtils.IsOpaque(argb))
+	}
+	_, err := s.StateLayer("primary", "onPrimaryish", StateHover)
+	assert.Error(t, err)
+	_, err = s.StateLayer("", "onPrimary", StateHover)
+	assert.Error(t, err)
+}
+
+func TestDisabled(t *testing.T) {
+	container, content := Disabled(0xffffffff, 0xff000000)
+	assert.Equal(t, 0xffe0e0e0, container)
+	assert.Equal(t, 0xff8b8b8b, content)
+
+	s := NewDarkScheme(0xff6750a4)
+	container, content = Disabled(s.Surface, s.OnSurface)
+	assert.Equal(t, colorUtils.Overlay(s.Surface, s.OnSurface, 0.12), container)
+	assert.Equal(t, colorUtils.Overlay(container, s.OnSurface, 0.38), content)
+}
+
+func TestRole(t *testing.T) {
+	s := NewLightScheme(0xff6750a4)
+	argb, ok := s.Role("surfaceContainerHigh")
+	assert.True(t, ok)
+	assert.Equal(t, s.SurfaceContainerHigh, argb)
+	_, ok = s.Role("SurfaceContainerHigh")
+	assert.False(t, ok)
+}
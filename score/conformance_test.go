@@ -0,0 +1,42 @@
+package score
+
+import (
+	"github.com/gio-eui/md3-colors/internal/golden"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strconv"
+	"testing"
+)
+
+// The golden vectors in testdata are transcribed from the test suites of Material Color
+// Utilities, so that any divergence from the reference implementations fails go test.
+
+func TestConformanceScore(t *testing.T) {
+	var vectors struct {
+		Cases []struct {
+			Name               string         `json:"name"`
+			ColorsToPopulation map[string]int `json:"colorsToPopulation"`
+			Desired            int            `json:"desired"`
+			FallbackColorArgb  golden.Argb    `json:"fallbackColorArgb"`
+			Filter             bool           `json:"filter"`
+			Colors             []golden.Argb  `json:"colors"`
+		} `json:"cases"`
+	}
+	golden.Load(t, "score.json", &vectors)
+	require.NotEmpty(t, vectors.Cases)
+
+	for _, c := range vectors.Cases {
+		colorsToPopulation := make(map[int]int, len(c.ColorsToPopulation))
+		for hex, population := range c.ColorsToPopulation {
+			argb, err := strconv.ParseInt(hex, 0, 64)
+			require.NoError(t, err)
+			colorsToPopulation[int(argb)] = population
+		}
+		expected := make([]int, len(c.Colors))
+		for i, color := range c.Colors {
+			expected[i] = int(color)
+		}
+		options := Options{Desired: c.Desired, FallbackColorArgb: int(c.FallbackColorArgb), Filter: c.Filter}
+		assert.Equal(t, expected, Score(colorsToPopulation, options), c.Name)
+	}
+}
@@ -0,0 +1,157 @@
+package score
+
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"github.com/gio-eui/md3-colors/hct"
+	mathUtils "github.com/gio-eui/md3-colors/utils/math"
+	"math"
+	"sort"
+)
+
+const (
+	targetChroma            = 48.0 // A1 Chroma
+	weightProportion        = 0.7
+	weightChromaAbove       = 0.3
+	weightChromaBelow       = 0.1
+	cutoffChroma            = 5.0
+	cutoffExcitedProportion = 0.01
+)
+
+// Options configures Score.
+type Options struct {
+	// Desired is the maximum number of colors returned.
+	Desired int
+	// FallbackColorArgb is returned when no color is suitable for a theme.
+	FallbackColorArgb int
+	// Filter drops colors that are unsuitable for a theme, such as grays and colors used by
+	// few pixels.
+	Filter bool
+}
+
+// DefaultOptions returns the Options of upstream: 4 colors, Google blue as fallback, and
+// filtering.
+func DefaultOptions() Options {
+	return Options{
+		Desired:           4,
+		FallbackColorArgb: 0xff4285f4,
+		Filter:            true,
+	}
+}
+
+type scoredHct struct {
+	hct   *hct.Hct
+	score float64
+}
+
+// Score ranks colors based on suitability for being used for a UI theme, and returns the
+// colors in descending order of score.
+//
+// Given a large set of colors, remove colors that are unsuitable for a UI theme, and rank the
+// rest based on suitability. Enables use of a high cluster count for image quantization, thus
+// ensuring colors aren't muddied, while curating the high cluster count to a much smaller
+// number of appropriate choices.
+//
+// [colorsToPopulation] maps colors to the number of pixels they represent, as returned by
+// quantize.Celebi. The result is never empty: it holds [options].FallbackColorArgb when no
+// color is suitable.
+func Score(colorsToPopulation map[int]int, options Options) []int {
+	// Iterate in a stable order, so that ties are broken the same way every time.
+	argbs := make([]int, 0, len(colorsToPopulation))
+	for argb := range colorsToPopulation {
+		argbs = append(argbs, argb)
+	}
+	sort.Ints(argbs)
+
+	// Get the HCT color for each Argb value, while finding the per hue count and total count.
+	colorsHct := make([]*hct.Hct, 0, len(argbs))
+	var huePopulation [360]int
+	populationSum := 0.0
+	for _, argb := range argbs {
+		color := hct.NewHctFromInt(argb)
+		colorsHct = append(colorsHct, color)
+		hue := int(math.Floor(color.GetHue()))
+		huePopulation[hue] += colorsToPopulation[argb]
+		populationSum += float64(colorsToPopulation[argb])
+	}
+
+	// Hues with more usage in neighboring 30 degree slice get a larger number.
+	var hueExcitedProportions [360]float64
+	for hue := 0; hue < 360; hue++ {
+		proportion := float64(huePopulation[hue]) / populationSum
+		for i := hue - 14; i < hue+16; i++ {
+			neighborHue := mathUtils.SanitizeDegreesInt(i)
+			hueExcitedProportions[neighborHue] += proportion
+		}
+	}
+
+	// Scores each HCT color based on usage and chroma, while optionally filtering out values
+	// that do not have enough chroma or usage.
+	var scoredHcts []scoredHct
+	for _, color := range colorsHct {
+		hue := mathUtils.SanitizeDegreesInt(int(math.Round(color.GetHue())))
+		proportion := hueExcitedProportions[hue]
+		if options.Filter && (color.GetChroma() < cutoffChroma || proportion <= cutoffExcitedProportion) {
+			continue
+		}
+
+		proportionScore := proportion * 100.0 * weightProportion
+		chromaWeight := weightChromaAbove
+		if color.GetChroma() < targetChroma {
+			chromaWeight = weightChromaBelow
+		}
+		chromaScore := (color.GetChroma() - targetChroma) * chromaWeight
+		scoredHcts = append(scoredHcts, scoredHct{hct: color, score: proportionScore + chromaScore})
+	}
+	// Sorted so that colors with higher scores come first.
+	sort.SliceStable(scoredHcts, func(i, j int) bool {
+		return scoredHcts[i].score > scoredHcts[j].score
+	})
+
+	// Iterates through potential hue differences in degrees in order to select the colors with
+	// the largest distribution of hues possible. Starting at 90 degrees (maximum difference for
+	// 4 colors) then decreasing down to a 15 degree minimum.
+	var chosenColors []*hct.Hct
+	for differenceDegrees := 90; differenceDegrees >= 15; differenceDegrees-- {
+		chosenColors = chosenColors[:0]
+		for _, entry := range scoredHcts {
+			hasDuplicateHue := false
+			for _, chosenHct := range chosenColors {
+				if mathUtils.DifferenceDegrees(entry.hct.GetHue(), chosenHct.GetHue()) < float64(differenceDegrees) {
+					hasDuplicateHue = true
+					break
+				}
+			}
+			if !hasDuplicateHue {
+				chosenColors = append(chosenColors, entry.hct)
+			}
+			if len(chosenColors) >= options.Desired {
+				break
+			}
+		}
+		if len(chosenColors) >= options.Desired {
+			break
+		}
+	}
+
+	if len(chosenColors) == 0 {
+		return []int{options.FallbackColorArgb}
+	}
+	colors := make([]int, len(chosenColors))
+	for i, chosenHct := range chosenColors {
+		colors[i] = chosenHct.ToInt()
+	}
+	return colors
+}
@@ -0,0 +1,39 @@
+package score
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestScorePrioritizesChroma(t *testing.T) {
+	colorsToPopulation := map[int]int{0xff000000: 1, 0xffffffff: 1, 0xff0000ff: 1}
+	assert.Equal(t, []int{0xff0000ff}, Score(colorsToPopulation, DefaultOptions()))
+}
+
+func TestScorePrioritizesChromaWhenProportionsEqual(t *testing.T) {
+	colorsToPopulation := map[int]int{0xffff0000: 1, 0xff00ff00: 1, 0xff0000ff: 1}
+	assert.Equal(t, []int{0xffff0000, 0xff00ff00, 0xff0000ff}, Score(colorsToPopulation, DefaultOptions()))
+}
+
+func TestScoreGeneratesGoogleBlueWhenNoColorsAvailable(t *testing.T) {
+	assert.Equal(t, []int{0xff4285f4}, Score(map[int]int{0xff000000: 1}, DefaultOptions()))
+	assert.Equal(t, []int{0xff4285f4}, Score(nil, DefaultOptions()))
+}
+
+func TestScoreDedupesNearbyHues(t *testing.T) {
+	colorsToPopulation := map[int]int{0xff008772: 1, 0xff318477: 1}
+	assert.Equal(t, []int{0xff008772}, Score(colorsToPopulation, DefaultOptions()))
+}
+
+func TestScoreMaximizesHueDistance(t *testing.T) {
+	colorsToPopulation := map[int]int{0xff008772: 1, 0xff008587: 1, 0xff007ebc: 1}
+	options := DefaultOptions()
+	options.Desired = 2
+	assert.Equal(t, []int{0xff007ebc, 0xff008772}, Score(colorsToPopulation, options))
+}
+
+func TestScoreWithoutFilter(t *testing.T) {
+	colorsToPopulation := map[int]int{0xff7ea16d: 67, 0xffd8ccae: 67, 0xff835c0d: 49}
+	options := Options{Desired: 3, FallbackColorArgb: 0xff8d3819, Filter: false}
+	assert.Equal(t, []int{0xff7ea16d, 0xffd8ccae, 0xff835c0d}, Score(colorsToPopulation, options))
+}
@@ -0,0 +1,167 @@
+package theme
+
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	"fmt"
+	"github.com/gio-eui/md3-colors/palettes"
+	"github.com/gio-eui/md3-colors/quantize"
+	"github.com/gio-eui/md3-colors/scheme"
+	"github.com/gio-eui/md3-colors/score"
+	"image"
+	"image/color"
+)
+
+// Theme bundles everything derived from a source color: the light and dark schemes, the tonal
+// palettes they pick their colors from, and the groups of custom colors.
+type Theme struct {
+	// Source is the ARGB source color of the theme.
+	Source       int
+	Variant      scheme.Variant
+	Schemes      Schemes
+	Palettes     Palettes
+	CustomColors []*scheme.CustomColorGroup
+}
+
+// Schemes holds the light and dark schemes of a Theme.
+type Schemes struct {
+	Light *scheme.Scheme
+	Dark  *scheme.Scheme
+}
+
+// Palettes holds the tonal palettes of a Theme, under their upstream names.
+type Palettes struct {
+	Primary        *palettes.TonalPalette
+	Secondary      *palettes.TonalPalette
+	Tertiary       *palettes.TonalPalette
+	Neutral        *palettes.TonalPalette
+	NeutralVariant *palettes.TonalPalette
+	Error          *palettes.TonalPalette
+}
+
+// NamedPalette is a tonal palette of a Theme with its name.
+type NamedPalette struct {
+	// Name is the name of the palette in lower camel case, such as "neutralVariant".
+	Name    string
+	Palette *palettes.TonalPalette
+}
+
+// NewTheme creates the Theme of [variant] from an ARGB source color, with groups for
+// [customColors]. It returns an error if the name of a custom color is invalid, see
+// scheme.CustomColor.Validate, or if two custom colors have the same name.
+func NewTheme(source int, variant scheme.Variant, customColors ...scheme.CustomColor) (*Theme, error) {
+	core := palettes.NewCorePaletteFromInt(source)
+	if variant == scheme.VariantContent {
+		core = palettes.NewContentCorePaletteFromInt(source)
+	}
+
+	theme := &Theme{
+		Source:  source,
+		Variant: variant,
+		Schemes: Schemes{
+			Light: scheme.NewLightSchemeFromCorePalette(core),
+			Dark:  scheme.NewDarkSchemeFromCorePalette(core),
+		},
+		Palettes: Palettes{
+			Primary:        core.A1,
+			Secondary:      core.A2,
+			Tertiary:       core.A3,
+			Neutral:        core.N1,
+			NeutralVariant: core.N2,
+			Error:          core.Error,
+		},
+	}
+	names := make(map[string]string)
+	for _, customColor := range customColors {
+		group, err := scheme.NewCustomColorGroup(source, customColor)
+		if err != nil {
+			return nil, err
+		}
+		if other, ok := names[group.Name()]; ok {
+			return nil, fmt.Errorf("custom color names %q and %q collide", other, customColor.Name)
+		}
+		names[group.Name()] = customColor.Name
+		theme.CustomColors = append(theme.CustomColors, group)
+	}
+	return theme, nil
+}
+
+// NewThemeFromSourceColor creates a Theme from an ARGB source color, with groups for
+// [customColors]. It returns the errors of NewTheme.
+func NewThemeFromSourceColor(source int, customColors ...scheme.CustomColor) (*Theme, error) {
+	return NewTheme(source, scheme.VariantDefault, customColors...)
+}
+
+// NewThemeFromImage creates a Theme from the source color of [img], see
+// SourceColorFromImage, with groups for [customColors]. It returns the errors of NewTheme.
+func NewThemeFromImage(img image.Image, customColors ...scheme.CustomColor) (*Theme, error) {
+	return NewThemeFromSourceColor(SourceColorFromImage(img), customColors...)
+}
+
+// SourceColorFromImage returns the ARGB color of [img] best suited as the source color of a
+// theme.
+//
+// The opaque pixels of [img] are quantized to 128 colors, which are ranked by score.Score;
+// images without a suitable color, such as grayscale images, fall back to Google blue.
+func SourceColorFromImage(img image.Image) int {
+	bounds := img.Bounds()
+	pixels := make([]int, 0, bounds.Dx()*bounds.Dy())
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			c := color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)
+			if c.A < 255 {
+				continue
+			}
+			pixels = append(pixels, 0xff000000|int(c.R)<<16|int(c.G)<<8|int(c.B))
+		}
+	}
+	return score.Score(quantize.Celebi(pixels, 128), score.DefaultOptions())[0]
+}
+
+// Scheme returns the dark scheme of the Theme if [isDark] is true, and the light scheme
+// otherwise.
+func (t *Theme) Scheme(isDark bool) *scheme.Scheme {
+	if isDark {
+		return t.Schemes.Dark
+	}
+	return t.Schemes.Light
+}
+
+// Roles returns every color role of the light or dark scheme of the Theme, followed by the
+// roles of its custom colors.
+func (t *Theme) Roles(isDark bool) []scheme.Role {
+	roles := t.Scheme(isDark).Roles()
+	for _, group := range t.CustomColors {
+		roles = append(roles, group.Roles(isDark)...)
+	}
+	return roles
+}
+
+// NamedPalettes returns every tonal palette of the Theme, followed by the palettes of its
+// custom colors, named after the custom colors.
+func (t *Theme) NamedPalettes() []NamedPalette {
+	named := []NamedPalette{
+		{"primary", t.Palettes.Primary},
+		{"secondary", t.Palettes.Secondary},
+		{"tertiary", t.Palettes.Tertiary},
+		{"neutral", t.Palettes.Neutral},
+		{"neutralVariant", t.Palettes.NeutralVariant},
+		{"error", t.Palettes.Error},
+	}
+	for _, group := range t.CustomColors {
+		named = append(named, NamedPalette{group.Name(), group.Palette})
+	}
+	return named
+}
@@ -0,0 +1,101 @@
+package theme
+
+import (
+	"github.com/gio-eui/md3-colors/hct"
+	"github.com/gio-eui/md3-colors/palettes"
+	"github.com/gio-eui/md3-colors/scheme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestNewThemeFromSourceColor(t *testing.T) {
+	warning := scheme.CustomColor{Name: "warning", Value: 0xfffbbc05, Blend: true}
+	theme, err := NewThemeFromSourceColor(0xff6750a4, warning)
+	require.NoError(t, err)
+
+	assert.Equal(t, 0xff6750a4, theme.Source)
+	assert.Equal(t, scheme.VariantDefault, theme.Variant)
+	assert.Equal(t, scheme.NewLightScheme(0xff6750a4), theme.Schemes.Light)
+	assert.Equal(t, scheme.NewDarkScheme(0xff6750a4), theme.Schemes.Dark)
+	assert.Same(t, theme.Schemes.Dark, theme.Scheme(true))
+
+	core := palettes.NewCorePaletteFromInt(0xff6750a4)
+	assert.Equal(t, core.A1.Tone(40), theme.Palettes.Primary.Tone(40))
+	assert.Equal(t, core.N2.Tone(30), theme.Palettes.NeutralVariant.Tone(30))
+	assert.Equal(t, core.Error.Tone(40), theme.Palettes.Error.Tone(40))
+
+	require.Len(t, theme.CustomColors, 1)
+	group, err := scheme.NewCustomColorGroup(0xff6750a4, warning)
+	require.NoError(t, err)
+	assert.Equal(t, group.Light, theme.CustomColors[0].Light)
+}
+
+func TestNewThemeContentVariant(t *testing.T) {
+	theme, err := NewTheme(0xff00796b, scheme.VariantContent)
+	require.NoError(t, err)
+	assert.Equal(t, scheme.NewLightContentScheme(0xff00796b), theme.Schemes.Light)
+	assert.Equal(t, scheme.NewDarkContentScheme(0xff00796b), theme.Schemes.Dark)
+}
+
+func TestThemeRoles(t *testing.T) {
+	theme, err := NewThemeFromSourceColor(0xff6750a4, scheme.CustomColor{Name: "brand-teal", Value: 0xff00796b})
+	require.NoError(t, err)
+	roles := theme.Roles(true)
+	schemeRoles := theme.Schemes.Dark.Roles()
+	require.Len(t, roles, len(schemeRoles)+4)
+	assert.Equal(t, schemeRoles, roles[:len(schemeRoles)])
+	assert.Equal(t, scheme.Role{Name: "onBrandTealContainer", Argb: theme.CustomColors[0].Dark.OnColorContainer}, roles[len(roles)-1])
+}
+
+func TestThemeNamedPalettes(t *testing.T) {
+	theme, err := NewThemeFromSourceColor(0xff6750a4, scheme.CustomColor{Name: "warning", Value: 0xfffbbc05})
+	require.NoError(t, err)
+	var names []string
+	for _, named := range theme.NamedPalettes() {
+		require.NotNil(t, named.Palette, named.Name)
+		names = append(names, named.Name)
+	}
+	assert.Equal(t, []string{"primary", "secondary", "tertiary", "neutral", "neutralVariant", "error", "warning"}, names)
+	assert.Same(t, theme.Palettes.Tertiary, theme.NamedPalettes()[2].Palette)
+}
+
+func TestNewThemeRejectsInvalidCustomColors(t *testing.T) {
+	_, err := NewThemeFromSourceColor(0xff6750a4, scheme.CustomColor{Name: "primary", Value: 0xff00796b})
+	assert.Error(t, err)
+	_, err = NewThemeFromSourceColor(0xff6750a4,
+		scheme.CustomColor{Name: "brand-teal", Value: 0xff00796b},
+		scheme.CustomColor{Name: "brand_teal", Value: 0xff00796b})
+	assert.Error(t, err)
+}
+
+func TestSourceColorFromImage(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 32, 32))
+	for y := 0; y < 32; y++ {
+		for x := 0; x < 32; x++ {
+			switch {
+			case y < 24:
+				img.SetNRGBA(x, y, color.NRGBA{R: 0x00, G: 0x79, B: 0x6b + uint8(x%4), A: 0xff})
+			case x < 16:
+				img.SetNRGBA(x, y, color.NRGBA{R: 0x80, G: 0x80, B: 0x80, A: 0xff})
+			default:
+				img.SetNRGBA(x, y, color.NRGBA{R: 0xff, G: 0x00, B: 0x00, A: 0x40})
+			}
+		}
+	}
+	source := SourceColorFromImage(img)
+	assert.InDelta(t, hct.NewHctFromInt(0xff00796b).GetHue(), hct.NewHctFromInt(source).GetHue(), 3.0)
+	theme, err := NewThemeFromImage(img)
+	require.NoError(t, err)
+	assert.Equal(t, source, theme.Source)
+}
+
+func TestSourceColorFromGrayscaleImageFallsBack(t *testing.T) {
+	img := image.NewGray(image.Rect(0, 0, 8, 8))
+	for i := range img.Pix {
+		img.Pix[i] = uint8(i * 4)
+	}
+	assert.Equal(t, 0xff4285f4, SourceColorFromImage(img))
+}
@@ -0,0 +1,112 @@
+package colorUtils
+
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	mathUtils "github.com/gio-eui/md3-colors/utils/math"
+)
+
+// The functions below composite colors with the source-over operator of Porter and Duff.
+// Colors in ARGB format are straight, not premultiplied, unless stated otherwise.
+//
+// Compositing in gamma-encoded sRGB matches browsers, Android and Gio. Compositing in linear
+// sRGB is physically correct, and brighter where a light color is blended over a dark one.
+
+// CompositeOver composites [foreground] over [background] in gamma-encoded sRGB, and returns
+// the result in ARGB format.
+//
+// The result is opaque whenever [background] is opaque.
+func CompositeOver(foreground, background int) int {
+	return Overlay(background, foreground, 1.0)
+}
+
+// CompositeOverLinear composites [foreground] over [background] in linear sRGB, and returns
+// the result in ARGB format.
+//
+// The result is opaque whenever [background] is opaque.
+func CompositeOverLinear(foreground, background int) int {
+	return OverlayLinear(background, foreground, 1.0)
+}
+
+// Overlay composites [foreground] over [background] in gamma-encoded sRGB, after multiplying
+// the alpha of [foreground] by [opacity], between 0.0 and 1.0.
+//
+// Overlays such as elevation tints and state layers use opacities that are not multiples of
+// 1/255, so [opacity] is applied without rounding it to an alpha component first.
+func Overlay(background, foreground int, opacity float64) int {
+	return overlay(background, foreground, opacity, false)
+}
+
+// OverlayLinear composites [foreground] over [background] in linear sRGB, after multiplying
+// the alpha of [foreground] by [opacity], between 0.0 and 1.0.
+func OverlayLinear(background, foreground int, opacity float64) int {
+	return overlay(background, foreground, opacity, true)
+}
+
+// overlay implements Overlay, and OverlayLinear if [linear] is true.
+func overlay(background, foreground int, opacity float64, linear bool) int {
+	fa := float64(AlphaFromArgb(foreground)) / 255.0 * mathUtils.ClampDouble(0.0, 1.0, opacity)
+	ba := float64(AlphaFromArgb(background)) / 255.0
+	a := fa + ba*(1.0-fa)
+	if a <= 0.0 {
+		return 0
+	}
+	composite := func(f, b int) int {
+		if linear {
+			return Delinearized((Linearized(f)*fa + Linearized(b)*ba*(1.0-fa)) / a)
+		}
+		return roundComponent((float64(f)*fa + float64(b)*ba*(1.0-fa)) / a)
+	}
+	return roundComponent(a*255.0)<<24 |
+		composite(RedFromArgb(foreground), RedFromArgb(background))<<16 |
+		composite(GreenFromArgb(foreground), GreenFromArgb(background))<<8 |
+		composite(BlueFromArgb(foreground), BlueFromArgb(background))
+}
+
+// Premultiply returns [argb] with its red, green, and blue components multiplied by its alpha,
+// as stored by image.RGBA and most graphics APIs.
+func Premultiply(argb int) int {
+	a := AlphaFromArgb(argb)
+	premultiplied := func(c int) int {
+		return roundComponent(float64(c*a) / 255.0)
+	}
+	return a<<24 |
+		premultiplied(RedFromArgb(argb))<<16 |
+		premultiplied(GreenFromArgb(argb))<<8 |
+		premultiplied(BlueFromArgb(argb))
+}
+
+// Unpremultiply returns the straight ARGB color of a premultiplied [argb], the inverse of
+// Premultiply.
+//
+// Colors with zero alpha have no color components, and are returned as 0.
+func Unpremultiply(argb int) int {
+	a := AlphaFromArgb(argb)
+	if a == 0 {
+		return 0
+	}
+	unpremultiplied := func(c int) int {
+		return roundComponent(float64(c) * 255.0 / float64(a))
+	}
+	return a<<24 |
+		unpremultiplied(RedFromArgb(argb))<<16 |
+		unpremultiplied(GreenFromArgb(argb))<<8 |
+		unpremultiplied(BlueFromArgb(argb))
+}
+
+// ArgbWithAlpha returns [argb] with its alpha component replaced by [alpha], between 0 and 255.
+func ArgbWithAlpha(argb, alpha int) int {
+	return mathUtils.ClampInt(0, 255, alpha)<<24 | argb&0x00ffffff
+}
@@ -0,0 +1,53 @@
+package colorUtils
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCompositeOver(t *testing.T) {
+	assert.Equal(t, 0xff6750a4, CompositeOver(0xff6750a4, 0xffffffff))
+	assert.Equal(t, 0xffffffff, CompositeOver(0x006750a4, 0xffffffff))
+	assert.Equal(t, 0xff808080, CompositeOver(0x80ffffff, 0xff000000))
+	assert.Equal(t, 0xc0aaaaaa, CompositeOver(0x80ffffff, 0x80000000))
+	assert.Equal(t, 0, CompositeOver(0, 0))
+}
+
+func TestOverlay(t *testing.T) {
+	assert.Equal(t, 0xff141414, Overlay(0xff000000, 0xffffffff, 0.08))
+	assert.Equal(t, 0xff0a0a0a, Overlay(0xff000000, 0x80ffffff, 0.08))
+	assert.Equal(t, 0xff000000, Overlay(0xff000000, 0xffffffff, -1))
+	assert.Equal(t, 0xffffffff, Overlay(0xff000000, 0xffffffff, 2))
+	for _, argb := range []int{0xff6750a4, 0xffb3261e, 0xff010203} {
+		assert.True(t, IsOpaque(Overlay(argb, 0x80ffffff, 0.5)))
+	}
+}
+
+func TestArgbWithAlpha(t *testing.T) {
+	assert.Equal(t, 0x806750a4, ArgbWithAlpha(0xff6750a4, 0x80))
+	assert.Equal(t, 0xff6750a4, ArgbWithAlpha(0x006750a4, 300))
+}
+
+func TestCompositeOverLinear(t *testing.T) {
+	assert.Equal(t, 0xff6750a4, CompositeOverLinear(0xff6750a4, 0xffffffff))
+	assert.Equal(t, 0xffffffff, CompositeOverLinear(0x006750a4, 0xffffffff))
+	// Half white over black is 50% of the light in linear sRGB, but L* 76 rather than 54.
+	assert.Equal(t, 0xffbcbcbc, CompositeOverLinear(0x80ffffff, 0xff000000))
+	assert.Equal(t, 0, CompositeOverLinear(0, 0))
+	for _, argb := range sampleArgbs() {
+		assert.Equal(t, argb, OverlayLinear(argb, 0xffffffff, 0))
+		assert.Equal(t, argb, OverlayLinear(0xff000000, argb, 1))
+	}
+}
+
+func TestPremultiply(t *testing.T) {
+	assert.Equal(t, 0x80402010, Premultiply(0x80804020))
+	assert.Equal(t, 0xff6750a4, Premultiply(0xff6750a4))
+	assert.Equal(t, 0x00000000, Premultiply(0x006750a4))
+	assert.Equal(t, 0x80804020, Unpremultiply(0x80402010))
+	assert.Equal(t, 0, Unpremultiply(0x00402010))
+	assert.Equal(t, 0x80ffffff, Unpremultiply(0x80ff8080))
+	for _, argb := range sampleArgbs() {
+		assert.Equal(t, argb, Unpremultiply(Premultiply(argb)))
+	}
+}
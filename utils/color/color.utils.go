@@ -19,13 +19,13 @@ import (
 	"math"
 )
 
-var srgbToXyz = [][]float64{
+var srgbToXyz = [3][3]float64{
 	{0.41233895, 0.35762064, 0.18051042},
 	{0.2126, 0.7152, 0.0722},
 	{0.01932141, 0.11916382, 0.95034478},
 }
 
-var xyzToSrgb = [][]float64{
+var xyzToSrgb = [3][3]float64{
 	{3.2413774792388685, -1.5376652402851851, -0.49885366846268053},
 	{-0.9691452513005321, 1.8758853451067872, 0.04156585616912061},
 	{0.05562093689691305, -0.20395524564742123, 1.0571799111220335},
@@ -39,7 +39,7 @@ func ArgbFromRgb(red, green, blue int) int {
 }
 
 // ArgbFromLinrgb converts a color from linear RGB components to ARGB format
-func ArgbFromLinrgb(linrgb []float64) int {
+func ArgbFromLinrgb(linrgb [3]float64) int {
 	r := Delinearized(linrgb[0])
 	g := Delinearized(linrgb[1])
 	b := Delinearized(linrgb[2])
@@ -84,12 +84,12 @@ func ArgbFromXyz(x, y, z float64) int {
 }
 
 // XyzFromArgb converts a color from ARGB format to XYZ components
-func XyzFromArgb(argb int) []float64 {
+func XyzFromArgb(argb int) [3]float64 {
 	r := Linearized(RedFromArgb(argb))
 	g := Linearized(GreenFromArgb(argb))
 	b := Linearized(BlueFromArgb(argb))
-	row := []float64{r, g, b}
-	return mathUtils.MatrixMultiply(row, srgbToXyz)
+	row := [3]float64{r, g, b}
+	return mathUtils.MatrixMultiply(row, &srgbToXyz)
 }
 
 // ArgbFromLab converts a color represented in Lab color space into an ARGB integer
@@ -132,6 +132,84 @@ func LabFromArgb(argb int) []float64 {
 	return []float64{l, a, b}
 }
 
+// OklabFromArgb converts a color from ARGB representation to Oklab representation.
+//
+// [argb] the ARGB representation of a color
+// Returns the L, a, and b coordinates of the color in Oklab, with 0.0 <= L <= 1.0
+func OklabFromArgb(argb int) []float64 {
+	r := Linearized(RedFromArgb(argb)) / 100.0
+	g := Linearized(GreenFromArgb(argb)) / 100.0
+	b := Linearized(BlueFromArgb(argb)) / 100.0
+	return oklabFromLinrgb(r, g, b)
+}
+
+// ArgbFromOklab converts a color represented in Oklab color space into an ARGB integer.
+//
+// Colors outside of sRGB are clipped per channel; see ArgbFromOklch for gamut mapping.
+func ArgbFromOklab(l, a, b float64) int {
+	return ArgbFromLinrgb(linrgbFromOklab(l, a, b))
+}
+
+// OklchFromArgb converts a color from ARGB representation to OKLCH, the polar form of Oklab.
+//
+// [argb] the ARGB representation of a color
+// Returns the L, C, and h coordinates of the color, with 0.0 <= L <= 1.0 and 0.0 <= h < 360.0
+func OklchFromArgb(argb int) []float64 {
+	lab := OklabFromArgb(argb)
+	return oklchFromOklab(lab[0], lab[1], lab[2])
+}
+
+// ArgbFromOklch converts a color represented in OKLCH into an ARGB integer.
+//
+// Colors outside of sRGB are gamut mapped with the CSS Color 4 algorithm: chroma is reduced,
+// keeping lightness and hue, until clipping the color changes it by less than a just
+// noticeable difference in Oklab.
+func ArgbFromOklch(l, c, h float64) int {
+	if l >= 1.0 {
+		return 0xffffffff
+	}
+	if l <= 0.0 {
+		return 0xff000000
+	}
+	origin := oklabFromOklch(l, c, h)
+	linrgb := linrgbFromOklab(origin[0], origin[1], origin[2])
+	if isLinrgbInSrgb(linrgb) {
+		return ArgbFromLinrgb(linrgb)
+	}
+
+	const jnd = 0.02
+	const epsilon = 0.0001
+	clipped := clipLinrgb(linrgb)
+	if deltaEOk(clipped, origin) < jnd {
+		return ArgbFromLinrgb(clipped)
+	}
+	low := 0.0
+	high := c
+	lowInGamut := true
+	for high-low > epsilon {
+		chroma := (low + high) / 2.0
+		current := oklabFromOklch(l, chroma, h)
+		currentLinrgb := linrgbFromOklab(current[0], current[1], current[2])
+		if lowInGamut && isLinrgbInSrgb(currentLinrgb) {
+			low = chroma
+			continue
+		}
+		clipped = clipLinrgb(currentLinrgb)
+		e := deltaEOk(clipped, current)
+		if e < jnd {
+			if jnd-e < epsilon {
+				return ArgbFromLinrgb(clipped)
+			}
+			lowInGamut = false
+			low = chroma
+		} else {
+			high = chroma
+		}
+	}
+	mapped := oklabFromOklch(l, low, h)
+	return ArgbFromLinrgb(clipLinrgb(linrgbFromOklab(mapped[0], mapped[1], mapped[2])))
+}
+
 // ArgbFromLstar converts an L* value to an ARGB representation.
 //
 // [lstar] L* in L*a*b*
@@ -214,6 +292,21 @@ func LstarFromY(y float64) float64 {
 // [rgbComponent] 0 <= rgb_component <= 255, represents R/G/B channel
 // Returns 0.0 <= output <= 100.0, color channel converted to linear RGB space
 func Linearized(rgbComponent int) float64 {
+	if rgbComponent >= 0 && rgbComponent <= 255 {
+		return linearizedTable[rgbComponent]
+	}
+	return linearized(rgbComponent)
+}
+
+// linearizedTable caches Linearized for every 8-bit component.
+var linearizedTable = func() (table [256]float64) {
+	for i := range table {
+		table[i] = linearized(i)
+	}
+	return table
+}()
+
+func linearized(rgbComponent int) float64 {
 	normalized := float64(rgbComponent) / 255.0
 	if normalized <= 0.040449936 {
 		return normalized / 12.92 * 100.0
@@ -262,3 +355,103 @@ func labInvf(ft float64) float64 {
 		return (116*ft - 16) / kappa
 	}
 }
+
+func oklabFromLinrgb(r, g, b float64) []float64 {
+	l := math.Cbrt(0.4122214708*r + 0.5363325363*g + 0.0514459929*b)
+	m := math.Cbrt(0.2119034982*r + 0.6806995451*g + 0.1073969566*b)
+	s := math.Cbrt(0.0883024619*r + 0.2817188376*g + 0.6299787005*b)
+	return []float64{
+		0.2104542553*l + 0.7936177850*m - 0.0040720468*s,
+		1.9779984951*l - 2.4285922050*m + 0.4505937099*s,
+		0.0259040371*l + 0.7827717662*m - 0.8086757660*s,
+	}
+}
+
+// linrgbFromOklab returns unclamped linear sRGB components, between 0.0 and 100.0 for colors
+// inside sRGB.
+func linrgbFromOklab(l, a, b float64) [3]float64 {
+	lPrime := l + 0.3963377774*a + 0.2158037573*b
+	mPrime := l - 0.1055613458*a - 0.0638541728*b
+	sPrime := l - 0.0894841775*a - 1.2914855480*b
+	lCubed := lPrime * lPrime * lPrime
+	mCubed := mPrime * mPrime * mPrime
+	sCubed := sPrime * sPrime * sPrime
+	return [3]float64{
+		(4.0767416621*lCubed - 3.3077115913*mCubed + 0.2309699292*sCubed) * 100.0,
+		(-1.2684380046*lCubed + 2.6097574011*mCubed - 0.3413193965*sCubed) * 100.0,
+		(-0.0041960863*lCubed - 0.7034186147*mCubed + 1.7076147010*sCubed) * 100.0,
+	}
+}
+
+func oklchFromOklab(l, a, b float64) []float64 {
+	h := math.Atan2(b, a) * 180.0 / math.Pi
+	if h < 0 {
+		h += 360.0
+	}
+	return []float64{l, math.Hypot(a, b), h}
+}
+
+func oklabFromOklch(l, c, h float64) []float64 {
+	hRad := h * math.Pi / 180.0
+	return []float64{l, c * math.Cos(hRad), c * math.Sin(hRad)}
+}
+
+func isLinrgbInSrgb(linrgb [3]float64) bool {
+	for _, component := range linrgb {
+		if component < -0.0001 || component > 100.0001 {
+			return false
+		}
+	}
+	return true
+}
+
+func clipLinrgb(linrgb [3]float64) [3]float64 {
+	return [3]float64{
+		mathUtils.ClampDouble(0.0, 100.0, linrgb[0]),
+		mathUtils.ClampDouble(0.0, 100.0, linrgb[1]),
+		mathUtils.ClampDouble(0.0, 100.0, linrgb[2]),
+	}
+}
+
+// deltaEOk returns the Euclidean distance between clipped linear sRGB components and an Oklab color.
+func deltaEOk(linrgb [3]float64, lab []float64) float64 {
+	clippedLab := oklabFromLinrgb(linrgb[0]/100.0, linrgb[1]/100.0, linrgb[2]/100.0)
+	dL := clippedLab[0] - lab[0]
+	dA := clippedLab[1] - lab[1]
+	dB := clippedLab[2] - lab[2]
+	return math.Sqrt(dL*dL + dA*dA + dB*dB)
+}
+
+// LabsFromArgbs converts every ARGB color of [src] to L*a*b* and stores the results in [dst],
+// interleaved as L*, a*, b* triples. [dst] must hold at least 3 * len(src) values; alpha is
+// ignored. No memory is allocated per pixel.
+func LabsFromArgbs(dst []float64, src []uint32) {
+	_ = dst[:3*len(src)]
+	whitePoint := whitePointD65
+	matrix := srgbToXyz
+	for i, argb := range src {
+		linearR := Linearized(int(argb>>16) & 0xff)
+		linearG := Linearized(int(argb>>8) & 0xff)
+		linearB := Linearized(int(argb) & 0xff)
+		x := matrix[0][0]*linearR + matrix[0][1]*linearG + matrix[0][2]*linearB
+		y := matrix[1][0]*linearR + matrix[1][1]*linearG + matrix[1][2]*linearB
+		z := matrix[2][0]*linearR + matrix[2][1]*linearG + matrix[2][2]*linearB
+		fx := labF(x / whitePoint[0])
+		fy := labF(y / whitePoint[1])
+		fz := labF(z / whitePoint[2])
+		dst[3*i] = 116.0*fy - 16
+		dst[3*i+1] = 500.0 * (fx - fy)
+		dst[3*i+2] = 200.0 * (fy - fz)
+	}
+}
+
+// ArgbsFromLabs converts every L*, a*, b* triple interleaved in [src] to ARGB and stores the
+// results in [dst], which must hold at least len(src) / 3 values. No memory is allocated per
+// pixel.
+func ArgbsFromLabs(dst []uint32, src []float64) {
+	n := len(src) / 3
+	_ = dst[:n]
+	for i := 0; i < n; i++ {
+		dst[i] = uint32(ArgbFromLab(src[3*i], src[3*i+1], src[3*i+2]))
+	}
+}
@@ -0,0 +1,36 @@
+package colorUtils
+
+import (
+	"math"
+	"testing"
+)
+
+// The seed corpus for this target lives in testdata/fuzz; run it with
+// go test ./utils/color -run '^$' -fuzz '^FuzzArgbFromLab$'.
+
+func FuzzArgbFromLab(f *testing.F) {
+	f.Fuzz(func(t *testing.T, l, a, b float64) {
+		for _, value := range []float64{l, a, b} {
+			if math.IsNaN(value) || math.IsInf(value, 0) {
+				t.Skip()
+			}
+		}
+		if l < 0 || l > 100 || math.Abs(a) > 128 || math.Abs(b) > 128 {
+			t.Skip()
+		}
+		argb := ArgbFromLab(l, a, b)
+		if !IsOpaque(argb) {
+			t.Fatalf("ArgbFromLab(%v, %v, %v) = %#x is not opaque", l, a, b, argb)
+		}
+
+		lab := LabFromArgb(argb)
+		for _, value := range lab {
+			if math.IsNaN(value) || math.IsInf(value, 0) {
+				t.Fatalf("ArgbFromLab(%v, %v, %v) = %#x has non-finite L*a*b* %v", l, a, b, argb, lab)
+			}
+		}
+		if roundTrip := ArgbFromLab(lab[0], lab[1], lab[2]); roundTrip != argb {
+			t.Fatalf("ArgbFromLab(%v, %v, %v) = %#x round trips as %#x", l, a, b, argb, roundTrip)
+		}
+	})
+}
@@ -0,0 +1,75 @@
+package colorUtils
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestOklabFromArgb(t *testing.T) {
+	white := OklabFromArgb(0xffffffff)
+	assert.InDelta(t, 1.0, white[0], 0.001)
+	assert.InDelta(t, 0.0, white[1], 0.001)
+	assert.InDelta(t, 0.0, white[2], 0.001)
+
+	red := OklabFromArgb(0xffff0000)
+	assert.InDelta(t, 0.62796, red[0], 0.001)
+	assert.InDelta(t, 0.22486, red[1], 0.001)
+	assert.InDelta(t, 0.12585, red[2], 0.001)
+
+	blue := OklchFromArgb(0xff0000ff)
+	assert.InDelta(t, 0.45201, blue[0], 0.001)
+	assert.InDelta(t, 0.31321, blue[1], 0.001)
+	assert.InDelta(t, 264.052, blue[2], 0.01)
+}
+
+func TestOklabRoundTrip(t *testing.T) {
+	for _, argb := range []int{0xff000000, 0xffffffff, 0xffff0000, 0xff00ff00, 0xff0000ff, 0xff6750a4, 0xffb3261e, 0xff7f7f7f} {
+		lab := OklabFromArgb(argb)
+		assert.Equal(t, argb, ArgbFromOklab(lab[0], lab[1], lab[2]))
+		lch := OklchFromArgb(argb)
+		assert.Equal(t, argb, ArgbFromOklch(lch[0], lch[1], lch[2]))
+	}
+}
+
+func TestArgbFromOklchGamutMapping(t *testing.T) {
+	assert.Equal(t, 0xffffffff, ArgbFromOklch(1.2, 0.3, 120))
+	assert.Equal(t, 0xff000000, ArgbFromOklch(-0.1, 0.3, 120))
+
+	// Out of gamut chroma keeps lightness and hue instead of clipping channels.
+	mapped := OklchFromArgb(ArgbFromOklch(0.7, 0.4, 145))
+	assert.InDelta(t, 0.7, mapped[0], 0.02)
+	assert.InDelta(t, 145, mapped[2], 3)
+	assert.Less(t, mapped[1], 0.4)
+}
+
+func TestLabsFromArgbs(t *testing.T) {
+	src := []uint32{0xff000000, 0xffffffff, 0xffff0000, 0xff6750a4}
+	labs := make([]float64, 3*len(src))
+	LabsFromArgbs(labs, src)
+	for i, argb := range src {
+		assert.InDeltaSlice(t, LabFromArgb(int(argb)), labs[3*i:3*i+3], 1e-9)
+	}
+
+	argbs := make([]uint32, len(src))
+	ArgbsFromLabs(argbs, labs)
+	assert.Equal(t, src, argbs)
+
+	assert.Zero(t, testing.AllocsPerRun(5, func() {
+		LabsFromArgbs(labs, src)
+		ArgbsFromLabs(argbs, labs)
+	}))
+}
+
+func BenchmarkLabsFromArgbs4K(b *testing.B) {
+	src := make([]uint32, 3840*2160)
+	for i := range src {
+		src[i] = 0xff000000 | uint32(i*2654435761)&0xffffff
+	}
+	dst := make([]float64, 3*len(src))
+	b.SetBytes(int64(4 * len(src)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		LabsFromArgbs(dst, src)
+	}
+}
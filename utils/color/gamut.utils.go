@@ -0,0 +1,157 @@
+package colorUtils
+
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	mathUtils "github.com/gio-eui/md3-colors/utils/math"
+	"math"
+)
+
+// Gamut identifies an RGB color space that shares the D65 white point of sRGB.
+//
+// Linear RGB components in a gamut use the same 0.0 to 100.0 scale as Linearized, and
+// gamma-encoded components are floating-point numbers between 0.0 and 1.0.
+type Gamut int
+
+const (
+	// GamutSrgb is the sRGB color space, the space of ARGB integers.
+	GamutSrgb Gamut = iota
+	// GamutDisplayP3 is the Display P3 color space, used by Apple and recent Android displays.
+	GamutDisplayP3
+	// GamutRec2020 is the ITU-R BT.2020 color space, used by UHD and HDR displays.
+	GamutRec2020
+)
+
+var displayP3ToXyz = [3][3]float64{
+	{0.48663265000000006, 0.2656631625, 0.19817418749999996},
+	{0.22900360000000003, 0.6917267249999999, 0.07926967499999998},
+	{0.0, 0.04511261250000004, 1.0437173874999999},
+}
+
+var xyzToDisplayP3 = [3][3]float64{
+	{2.4931807553289667, -0.9312655254971399, -0.40265972375888176},
+	{-0.829503115821079, 1.762694121119793, 0.02362508874173959},
+	{0.03585362578007171, -0.07618895478265221, 0.9570926215180217},
+}
+
+var rec2020ToXyz = [3][3]float64{
+	{0.6370101914111008, 0.14461502739696927, 0.16884478119192986},
+	{0.26272171736164046, 0.6779892755022618, 0.0592890071360975},
+	{0.0, 0.028072328847646908, 1.060757671152353},
+}
+
+var xyzToRec2020 = [3][3]float64{
+	{1.7165106697619736, -0.3556416699867159, -0.25334554182190727},
+	{-0.6666930011826243, 1.6165022083469107, 0.015768750389995017},
+	{0.01764363876745901, -0.04277978166904462, 0.9423050727200186},
+}
+
+// Constants of the BT.2020 transfer function.
+const (
+	rec2020Alpha = 1.09929682680944
+	rec2020Beta  = 0.018053968510807
+)
+
+// String returns the name of the gamut.
+func (g Gamut) String() string {
+	switch g {
+	case GamutDisplayP3:
+		return "display-p3"
+	case GamutRec2020:
+		return "rec2020"
+	default:
+		return "srgb"
+	}
+}
+
+// XyzFromLinrgb converts linear RGB components in the gamut to XYZ components.
+func (g Gamut) XyzFromLinrgb(linrgb [3]float64) [3]float64 {
+	switch g {
+	case GamutDisplayP3:
+		return mathUtils.MatrixMultiply(linrgb, &displayP3ToXyz)
+	case GamutRec2020:
+		return mathUtils.MatrixMultiply(linrgb, &rec2020ToXyz)
+	default:
+		return mathUtils.MatrixMultiply(linrgb, &srgbToXyz)
+	}
+}
+
+// LinrgbFromXyz converts XYZ components to linear RGB components in the gamut.
+//
+// Components of colors outside the gamut are returned unclamped, below 0.0 or above 100.0.
+func (g Gamut) LinrgbFromXyz(x, y, z float64) [3]float64 {
+	xyz := [3]float64{x, y, z}
+	switch g {
+	case GamutDisplayP3:
+		return mathUtils.MatrixMultiply(xyz, &xyzToDisplayP3)
+	case GamutRec2020:
+		return mathUtils.MatrixMultiply(xyz, &xyzToRec2020)
+	default:
+		return mathUtils.MatrixMultiply(xyz, &xyzToSrgb)
+	}
+}
+
+// Contains returns whether linear RGB components in the gamut describe a displayable color.
+func (g Gamut) Contains(linrgb [3]float64) bool {
+	for _, component := range linrgb {
+		if component < 0.0 || component > 100.01 {
+			return false
+		}
+	}
+	return true
+}
+
+// Linearized linearizes a gamma-encoded component of the gamut.
+//
+// [component] 0.0 <= component <= 1.0, represents R/G/B channel
+// Returns 0.0 <= output <= 100.0, color channel converted to linear RGB space
+func (g Gamut) Linearized(component float64) float64 {
+	if g == GamutRec2020 {
+		if component < rec2020Beta*4.5 {
+			return component / 4.5 * 100.0
+		}
+		return math.Pow((component+rec2020Alpha-1.0)/rec2020Alpha, 1.0/0.45) * 100.0
+	}
+	if component <= 0.040449936 {
+		return component / 12.92 * 100.0
+	}
+	return math.Pow((component+0.055)/1.055, 2.4) * 100.0
+}
+
+// Delinearized gamma-encodes a linear component of the gamut.
+//
+// [component] 0.0 <= component <= 100.0, represents linear R/G/B channel
+// Returns 0.0 <= output <= 1.0, color channel converted to the encoded space of the gamut
+func (g Gamut) Delinearized(component float64) float64 {
+	normalized := mathUtils.ClampDouble(0.0, 1.0, component/100.0)
+	if g == GamutRec2020 {
+		if normalized < rec2020Beta {
+			return normalized * 4.5
+		}
+		return rec2020Alpha*math.Pow(normalized, 0.45) - (rec2020Alpha - 1.0)
+	}
+	if normalized <= 0.0031308 {
+		return normalized * 12.92
+	}
+	return 1.055*math.Pow(normalized, 1.0/2.4) - 0.055
+}
+
+// ArgbFromRgbInGamut converts gamma-encoded components of [gamut] to the closest sRGB color
+// in ARGB format. Components outside of sRGB are clipped.
+func ArgbFromRgbInGamut(rgb []float64, gamut Gamut) int {
+	linrgb := [3]float64{gamut.Linearized(rgb[0]), gamut.Linearized(rgb[1]), gamut.Linearized(rgb[2])}
+	xyz := gamut.XyzFromLinrgb(linrgb)
+	return ArgbFromXyz(xyz[0], xyz[1], xyz[2])
+}
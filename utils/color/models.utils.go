@@ -0,0 +1,210 @@
+package colorUtils
+
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import (
+	mathUtils "github.com/gio-eui/md3-colors/utils/math"
+	"math"
+)
+
+// The RGB color models below work on gamma-encoded sRGB. RGB components are floating-point
+// numbers between 0.0 and 255.0, hues are in degrees between 0.0 and 360.0, and every other
+// component is a percentage between 0.0 and 100.0.
+
+// ArgbFromRgbFloat converts a color from floating-point RGB components to ARGB format.
+//
+// Components are rounded to the nearest integer and clamped between 0 and 255.
+func ArgbFromRgbFloat(red, green, blue float64) int {
+	return ArgbFromRgb(roundComponent(red), roundComponent(green), roundComponent(blue))
+}
+
+// HslFromRgb converts a color from RGB components to hue, saturation, and lightness.
+func HslFromRgb(red, green, blue float64) []float64 {
+	r, g, b := red/255.0, green/255.0, blue/255.0
+	maxC := math.Max(r, math.Max(g, b))
+	minC := math.Min(r, math.Min(g, b))
+	chroma := maxC - minC
+	l := (maxC + minC) / 2.0
+	s := 0.0
+	if chroma > 0 && l > 0 && l < 1 {
+		s = chroma / (1.0 - math.Abs(2.0*l-1.0))
+	}
+	return []float64{rgbHue(r, g, b, maxC, chroma), s * 100.0, l * 100.0}
+}
+
+// RgbFromHsl converts a color from hue, saturation, and lightness to RGB components.
+func RgbFromHsl(hue, saturation, lightness float64) []float64 {
+	s := mathUtils.ClampDouble(0.0, 100.0, saturation) / 100.0
+	l := mathUtils.ClampDouble(0.0, 100.0, lightness) / 100.0
+	chroma := (1.0 - math.Abs(2.0*l-1.0)) * s
+	return rgbFromHueChroma(hue, chroma, l-chroma/2.0)
+}
+
+// HslFromArgb converts a color from ARGB format to hue, saturation, and lightness.
+func HslFromArgb(argb int) []float64 {
+	return HslFromRgb(float64(RedFromArgb(argb)), float64(GreenFromArgb(argb)), float64(BlueFromArgb(argb)))
+}
+
+// ArgbFromHsl converts a color from hue, saturation, and lightness to ARGB format.
+func ArgbFromHsl(hue, saturation, lightness float64) int {
+	rgb := RgbFromHsl(hue, saturation, lightness)
+	return ArgbFromRgbFloat(rgb[0], rgb[1], rgb[2])
+}
+
+// HsvFromRgb converts a color from RGB components to hue, saturation, and value.
+func HsvFromRgb(red, green, blue float64) []float64 {
+	r, g, b := red/255.0, green/255.0, blue/255.0
+	maxC := math.Max(r, math.Max(g, b))
+	minC := math.Min(r, math.Min(g, b))
+	chroma := maxC - minC
+	s := 0.0
+	if maxC > 0 {
+		s = chroma / maxC
+	}
+	return []float64{rgbHue(r, g, b, maxC, chroma), s * 100.0, maxC * 100.0}
+}
+
+// RgbFromHsv converts a color from hue, saturation, and value to RGB components.
+func RgbFromHsv(hue, saturation, value float64) []float64 {
+	s := mathUtils.ClampDouble(0.0, 100.0, saturation) / 100.0
+	v := mathUtils.ClampDouble(0.0, 100.0, value) / 100.0
+	chroma := v * s
+	return rgbFromHueChroma(hue, chroma, v-chroma)
+}
+
+// HsvFromArgb converts a color from ARGB format to hue, saturation, and value.
+func HsvFromArgb(argb int) []float64 {
+	return HsvFromRgb(float64(RedFromArgb(argb)), float64(GreenFromArgb(argb)), float64(BlueFromArgb(argb)))
+}
+
+// ArgbFromHsv converts a color from hue, saturation, and value to ARGB format.
+func ArgbFromHsv(hue, saturation, value float64) int {
+	rgb := RgbFromHsv(hue, saturation, value)
+	return ArgbFromRgbFloat(rgb[0], rgb[1], rgb[2])
+}
+
+// HwbFromRgb converts a color from RGB components to hue, whiteness, and blackness.
+func HwbFromRgb(red, green, blue float64) []float64 {
+	hsv := HsvFromRgb(red, green, blue)
+	whiteness := (100.0 - hsv[1]) * hsv[2] / 100.0
+	return []float64{hsv[0], whiteness, 100.0 - hsv[2]}
+}
+
+// RgbFromHwb converts a color from hue, whiteness, and blackness to RGB components.
+//
+// When whiteness and blackness add up to more than 100, they are scaled down proportionally,
+// producing a gray.
+func RgbFromHwb(hue, whiteness, blackness float64) []float64 {
+	w := mathUtils.ClampDouble(0.0, 100.0, whiteness) / 100.0
+	b := mathUtils.ClampDouble(0.0, 100.0, blackness) / 100.0
+	if w+b >= 1.0 {
+		gray := w / (w + b) * 255.0
+		return []float64{gray, gray, gray}
+	}
+	v := 1.0 - b
+	return RgbFromHsv(hue, (1.0-w/v)*100.0, v*100.0)
+}
+
+// HwbFromArgb converts a color from ARGB format to hue, whiteness, and blackness.
+func HwbFromArgb(argb int) []float64 {
+	return HwbFromRgb(float64(RedFromArgb(argb)), float64(GreenFromArgb(argb)), float64(BlueFromArgb(argb)))
+}
+
+// ArgbFromHwb converts a color from hue, whiteness, and blackness to ARGB format.
+func ArgbFromHwb(hue, whiteness, blackness float64) int {
+	rgb := RgbFromHwb(hue, whiteness, blackness)
+	return ArgbFromRgbFloat(rgb[0], rgb[1], rgb[2])
+}
+
+// CmykFromRgb converts a color from RGB components to cyan, magenta, yellow, and key.
+//
+// This is the naive device-independent conversion; it does not use an ICC profile and will
+// not match the output of a calibrated print workflow.
+func CmykFromRgb(red, green, blue float64) []float64 {
+	r, g, b := red/255.0, green/255.0, blue/255.0
+	k := 1.0 - math.Max(r, math.Max(g, b))
+	if k >= 1.0 {
+		return []float64{0.0, 0.0, 0.0, 100.0}
+	}
+	c := (1.0 - r - k) / (1.0 - k)
+	m := (1.0 - g - k) / (1.0 - k)
+	y := (1.0 - b - k) / (1.0 - k)
+	return []float64{c * 100.0, m * 100.0, y * 100.0, k * 100.0}
+}
+
+// RgbFromCmyk converts a color from cyan, magenta, yellow, and key to RGB components.
+func RgbFromCmyk(cyan, magenta, yellow, key float64) []float64 {
+	k := 1.0 - mathUtils.ClampDouble(0.0, 100.0, key)/100.0
+	return []float64{
+		255.0 * (1.0 - mathUtils.ClampDouble(0.0, 100.0, cyan)/100.0) * k,
+		255.0 * (1.0 - mathUtils.ClampDouble(0.0, 100.0, magenta)/100.0) * k,
+		255.0 * (1.0 - mathUtils.ClampDouble(0.0, 100.0, yellow)/100.0) * k,
+	}
+}
+
+// CmykFromArgb converts a color from ARGB format to cyan, magenta, yellow, and key.
+func CmykFromArgb(argb int) []float64 {
+	return CmykFromRgb(float64(RedFromArgb(argb)), float64(GreenFromArgb(argb)), float64(BlueFromArgb(argb)))
+}
+
+// ArgbFromCmyk converts a color from cyan, magenta, yellow, and key to ARGB format.
+func ArgbFromCmyk(cyan, magenta, yellow, key float64) int {
+	rgb := RgbFromCmyk(cyan, magenta, yellow, key)
+	return ArgbFromRgbFloat(rgb[0], rgb[1], rgb[2])
+}
+
+// rgbHue returns the hue shared by HSL, HSV, and HWB, in degrees. Achromatic colors have hue 0.
+func rgbHue(r, g, b, maxC, chroma float64) float64 {
+	if chroma == 0 {
+		return 0.0
+	}
+	var hue float64
+	switch maxC {
+	case r:
+		hue = (g - b) / chroma
+	case g:
+		hue = (b-r)/chroma + 2.0
+	default:
+		hue = (r-g)/chroma + 4.0
+	}
+	return mathUtils.SanitizeDegreesDouble(hue * 60.0)
+}
+
+// rgbFromHueChroma returns RGB components from a hue, a chroma between 0.0 and 1.0, and the
+// amount [m] added to every component to match lightness or value.
+func rgbFromHueChroma(hue, chroma, m float64) []float64 {
+	huePrime := mathUtils.SanitizeDegreesDouble(hue) / 60.0
+	x := chroma * (1.0 - math.Abs(math.Mod(huePrime, 2.0)-1.0))
+	var r, g, b float64
+	switch {
+	case huePrime < 1:
+		r, g, b = chroma, x, 0
+	case huePrime < 2:
+		r, g, b = x, chroma, 0
+	case huePrime < 3:
+		r, g, b = 0, chroma, x
+	case huePrime < 4:
+		r, g, b = 0, x, chroma
+	case huePrime < 5:
+		r, g, b = x, 0, chroma
+	default:
+		r, g, b = chroma, 0, x
+	}
+	return []float64{(r + m) * 255.0, (g + m) * 255.0, (b + m) * 255.0}
+}
+
+func roundComponent(component float64) int {
+	return mathUtils.ClampInt(0, 255, int(math.Round(component)))
+}
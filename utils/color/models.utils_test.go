@@ -0,0 +1,70 @@
+package colorUtils
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func sampleArgbs() []int {
+	var argbs []int
+	for r := 0; r < 256; r += 15 {
+		for g := 0; g < 256; g += 15 {
+			for b := 0; b < 256; b += 15 {
+				argbs = append(argbs, ArgbFromRgb(r, g, b))
+			}
+		}
+	}
+	return append(argbs, 0xff6750a4, 0xffb3261e, 0xff7f7f7f, 0xff010203)
+}
+
+func TestHslFromArgb(t *testing.T) {
+	assert.InDeltaSlice(t, []float64{0, 100, 50}, HslFromArgb(0xffff0000), 1e-9)
+	assert.InDeltaSlice(t, []float64{0, 0, 100}, HslFromArgb(0xffffffff), 1e-9)
+	assert.InDeltaSlice(t, []float64{256.43, 34.4, 47.8}, HslFromArgb(0xff6750a4), 0.1)
+	assert.Equal(t, 0xff00ff00, ArgbFromHsl(120, 100, 50))
+	assert.Equal(t, 0xff0000ff, ArgbFromHsl(-120, 100, 50))
+}
+
+func TestHsvFromArgb(t *testing.T) {
+	assert.InDeltaSlice(t, []float64{240, 100, 100}, HsvFromArgb(0xff0000ff), 1e-9)
+	assert.InDeltaSlice(t, []float64{256.43, 51.2, 64.3}, HsvFromArgb(0xff6750a4), 0.1)
+	assert.Equal(t, 0xffffff00, ArgbFromHsv(60, 100, 100))
+}
+
+func TestHwbFromArgb(t *testing.T) {
+	assert.InDeltaSlice(t, []float64{0, 0, 0}, HwbFromArgb(0xffff0000), 1e-9)
+	assert.InDeltaSlice(t, []float64{0, 100, 0}, HwbFromArgb(0xffffffff), 1e-9)
+	assert.Equal(t, 0xff808080, ArgbFromHwb(200, 60, 60))
+}
+
+func TestCmykFromArgb(t *testing.T) {
+	assert.InDeltaSlice(t, []float64{0, 0, 0, 100}, CmykFromArgb(0xff000000), 1e-9)
+	assert.InDeltaSlice(t, []float64{100, 0, 100, 0}, CmykFromArgb(0xff00ff00), 1e-9)
+	assert.InDeltaSlice(t, []float64{37.2, 51.2, 0, 35.7}, CmykFromArgb(0xff6750a4), 0.1)
+	assert.Equal(t, 0xff00ffff, ArgbFromCmyk(100, 0, 0, 0))
+}
+
+func TestRgbModelsRoundTrip(t *testing.T) {
+	for _, argb := range sampleArgbs() {
+		hsl := HslFromArgb(argb)
+		assert.Equal(t, argb, ArgbFromHsl(hsl[0], hsl[1], hsl[2]))
+		hsv := HsvFromArgb(argb)
+		assert.Equal(t, argb, ArgbFromHsv(hsv[0], hsv[1], hsv[2]))
+		hwb := HwbFromArgb(argb)
+		assert.Equal(t, argb, ArgbFromHwb(hwb[0], hwb[1], hwb[2]))
+		cmyk := CmykFromArgb(argb)
+		assert.Equal(t, argb, ArgbFromCmyk(cmyk[0], cmyk[1], cmyk[2], cmyk[3]))
+	}
+}
+
+func TestRgbModelsFloatRoundTrip(t *testing.T) {
+	rgb := []float64{12.25, 200.5, 99.75}
+	hsl := HslFromRgb(rgb[0], rgb[1], rgb[2])
+	assert.InDeltaSlice(t, rgb, RgbFromHsl(hsl[0], hsl[1], hsl[2]), 1e-9)
+	hsv := HsvFromRgb(rgb[0], rgb[1], rgb[2])
+	assert.InDeltaSlice(t, rgb, RgbFromHsv(hsv[0], hsv[1], hsv[2]), 1e-9)
+	hwb := HwbFromRgb(rgb[0], rgb[1], rgb[2])
+	assert.InDeltaSlice(t, rgb, RgbFromHwb(hwb[0], hwb[1], hwb[2]), 1e-9)
+	cmyk := CmykFromRgb(rgb[0], rgb[1], rgb[2])
+	assert.InDeltaSlice(t, rgb, RgbFromCmyk(cmyk[0], cmyk[1], cmyk[2], cmyk[3]), 1e-9)
+}
@@ -118,9 +118,11 @@ func ToRadians(degrees float64) float64 {
 }
 
 // MatrixMultiply multiplies a 1x3 row vector with a 3x3 matrix.
-func MatrixMultiply(row []float64, matrix [][]float64) []float64 {
+//
+// Vectors and matrices are fixed-size arrays, so the product does not allocate.
+func MatrixMultiply(row [3]float64, matrix *[3][3]float64) [3]float64 {
 	a := row[0]*matrix[0][0] + row[1]*matrix[0][1] + row[2]*matrix[0][2]
 	b := row[0]*matrix[1][0] + row[1]*matrix[1][1] + row[2]*matrix[1][2]
 	c := row[0]*matrix[2][0] + row[1]*matrix[2][1] + row[2]*matrix[2][2]
-	return []float64{a, b, c}
+	return [3]float64{a, b, c}
 }
@@ -17,6 +17,8 @@ package stringsUtils
 import (
 	"fmt"
 	colorUtils "github.com/gio-eui/md3-colors/utils/color"
+	"strconv"
+	"strings"
 )
 
 func HexFromArgb(argb int) string {
@@ -25,3 +27,25 @@ func HexFromArgb(argb int) string {
 	green := colorUtils.GreenFromArgb(argb)
 	return fmt.Sprintf("#%02x%02x%02x", red, green, blue)
 }
+
+// ArgbFromHex parses a hex color code into an opaque ARGB color.
+//
+// [hex] is a color code of 3, 6, or 8 digits, optionally prefixed with '#' or "0x". The
+// alpha component of 8-digit codes, in AARRGGBB order, is ignored.
+func ArgbFromHex(hex string) (int, error) {
+	digits := strings.TrimPrefix(strings.TrimPrefix(hex, "#"), "0x")
+	switch len(digits) {
+	case 3:
+		digits = string([]byte{digits[0], digits[0], digits[1], digits[1], digits[2], digits[2]})
+	case 6:
+	case 8:
+		digits = digits[2:]
+	default:
+		return 0, fmt.Errorf("unexpected hex color code %q", hex)
+	}
+	rgb, err := strconv.ParseUint(digits, 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("unexpected hex color code %q: %w", hex, err)
+	}
+	return 0xff000000 | int(rgb), nil
+}
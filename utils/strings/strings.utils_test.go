@@ -0,0 +1,27 @@
+package stringsUtils
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestArgbFromHex(t *testing.T) {
+	for hex, expected := range map[string]int{
+		"#6750a4":    0xff6750a4,
+		"6750A4":     0xff6750a4,
+		"0xff6750a4": 0xff6750a4,
+		"#806750a4":  0xff6750a4,
+		"#fa0":       0xffffaa00,
+	} {
+		argb, err := ArgbFromHex(hex)
+		require.NoError(t, err, hex)
+		assert.Equal(t, expected, argb, hex)
+	}
+	assert.Equal(t, "#6750a4", HexFromArgb(0xff6750a4))
+
+	for _, hex := range []string{"", "#12345", "#gggggg", "#-12345"} {
+		_, err := ArgbFromHex(hex)
+		assert.Error(t, err, hex)
+	}
+}